@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+)
+
+// lineOverhead is the memory used by a line in addition to its bytes:
+// the string header and its index while sorting.
+const lineOverhead = 24
+
+// mergeFanIn is the largest number of runs merged at once, which bounds the
+// number of temporary files open at the same time. Like --batch-size of GNU
+// sort, groups of runs are merged into new temporary files until few enough
+// are left.
+const mergeFanIn = 16
+
+// runSet holds the sorted runs of the input. All but the last chunk of the
+// input are written to temporary files, which are closed until they are
+// merged, the last one is kept in memory.
+type runSet struct {
+	dir   string
+	names []string
+	last  []string
+}
+
+// sortChunks reads lines from the inputs until the memory limit is reached,
+// sorts them and writes them to a temporary file, and repeats until all
+// input is read.
+func (s *sorter) sortChunks(inputs []io.Reader) (*runSet, error) {
+	runs := &runSet{dir: s.opts.tempDir}
+	var lines []string
+	var size int64
+	for _, in := range inputs {
+		r := bufio.NewReader(in)
+		for {
+			line, err := r.ReadString('\n')
+			if len(line) > 0 {
+				line = strings.TrimSuffix(line, "\n")
+				lines = append(lines, line)
+				size += int64(len(line)) + lineOverhead
+				if size >= s.opts.memory {
+					if err := runs.write(s.sortLines(lines)); err != nil {
+						runs.remove()
+						return nil, err
+					}
+					lines, size = nil, 0
+				}
+			}
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				runs.remove()
+				return nil, err
+			}
+		}
+	}
+	runs.last = s.sortLines(lines)
+	return runs, nil
+}
+
+// write stores a sorted run in a new temporary file.
+func (rs *runSet) write(lines []string) error {
+	return rs.create(func(w *bufio.Writer) error {
+		for _, line := range lines {
+			w.WriteString(line)
+			w.WriteByte('\n')
+		}
+		return nil
+	})
+}
+
+// create stores the lines written by fill in a new temporary file, which is
+// closed when create returns.
+func (rs *runSet) create(fill func(w *bufio.Writer) error) error {
+	f, err := os.CreateTemp(rs.dir, "gosort-")
+	if err != nil {
+		return err
+	}
+	rs.names = append(rs.names, f.Name())
+	w := bufio.NewWriter(f)
+	if err := fill(w); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// remove deletes the temporary files.
+func (rs *runSet) remove() {
+	for _, name := range rs.names {
+		os.Remove(name)
+	}
+	rs.names = nil
+}
+
+// openRuns opens the temporary files of names and returns a reader for each of
+// them and a function that closes them.
+func openRuns(names []string) ([]*runReader, func(), error) {
+	var files []*os.File
+	closeFiles := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+	var readers []*runReader
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			closeFiles()
+			return nil, nil, err
+		}
+		files = append(files, f)
+		readers = append(readers, &runReader{r: bufio.NewReader(f)})
+	}
+	return readers, closeFiles, nil
+}
+
+// reduce merges groups of mergeFanIn run files into new temporary files,
+// until the remaining files and the run kept in memory can be merged at once.
+func (s *sorter) reduce(runs *runSet) error {
+	for len(runs.names) >= mergeFanIn {
+		names := runs.names
+		runs.names = nil
+		for len(names) > 0 {
+			group := names[:min(mergeFanIn, len(names))]
+			names = names[len(group):]
+			if err := s.mergeGroup(runs, group); err != nil {
+				// Leave the files to runs.remove.
+				runs.names = append(append(runs.names, group...), names...)
+				return err
+			}
+			for _, name := range group {
+				os.Remove(name)
+			}
+		}
+	}
+	return nil
+}
+
+// mergeGroup merges the run files of group into a new temporary file of runs.
+// Repeated lines are kept, so that -u only applies to the final merge.
+func (s *sorter) mergeGroup(runs *runSet, group []string) error {
+	readers, closeRuns, err := openRuns(group)
+	if err != nil {
+		return err
+	}
+	defer closeRuns()
+	return runs.create(func(w *bufio.Writer) error {
+		return s.mergeReaders(readers, w, false)
+	})
+}
+
+// runReader returns the lines of one sorted run in order.
+type runReader struct {
+	r     *bufio.Reader
+	lines []string
+	head  string
+}
+
+// next advances to the next line of the run. It returns false at the end.
+func (rr *runReader) next() (bool, error) {
+	if rr.r == nil {
+		if len(rr.lines) == 0 {
+			return false, nil
+		}
+		rr.head, rr.lines = rr.lines[0], rr.lines[1:]
+		return true, nil
+	}
+	line, err := rr.r.ReadString('\n')
+	if err == io.EOF && line == "" {
+		return false, nil
+	} else if err != nil && err != io.EOF {
+		return false, err
+	}
+	rr.head = strings.TrimSuffix(line, "\n")
+	return true, nil
+}
+
+// merge writes the lines of all runs to w in sorted order, after reducing
+// the number of run files to less than mergeFanIn.
+func (s *sorter) merge(runs *runSet, w io.Writer) error {
+	if err := s.reduce(runs); err != nil {
+		return err
+	}
+	readers, closeRuns, err := openRuns(runs.names)
+	if err != nil {
+		return err
+	}
+	defer closeRuns()
+	readers = append(readers, &runReader{lines: runs.last})
+	return s.mergeReaders(readers, w, s.opts.unique)
+}
+
+// mergeReaders writes the lines of the runs of readers to w in sorted order,
+// dropping lines with repeated keys if unique is true. It uses a binary heap
+// of runs ordered by their current line. Equal lines are taken from the
+// earlier run first, which keeps lines with equal keys in input order.
+func (s *sorter) mergeReaders(readers []*runReader, w io.Writer, unique bool) error {
+	less := func(i, j int) bool {
+		c := s.compare(readers[i].head, readers[j].head)
+		return c < 0 || c == 0 && i < j
+	}
+	var heap []int
+	down := func(i int) {
+		for {
+			m := i
+			if l := 2*i + 1; l < len(heap) && less(heap[l], heap[m]) {
+				m = l
+			}
+			if r := 2*i + 2; r < len(heap) && less(heap[r], heap[m]) {
+				m = r
+			}
+			if m == i {
+				return
+			}
+			heap[i], heap[m] = heap[m], heap[i]
+			i = m
+		}
+	}
+
+	for i, rr := range readers {
+		ok, err := rr.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap = append(heap, i)
+		}
+	}
+	for i := len(heap)/2 - 1; i >= 0; i-- {
+		down(i)
+	}
+
+	var prev string
+	written := false
+	for len(heap) > 0 {
+		rr := readers[heap[0]]
+		if !unique || !written || s.compareKeys(prev, rr.head) != 0 {
+			if _, err := io.WriteString(w, rr.head+"\n"); err != nil {
+				return err
+			}
+			prev, written = rr.head, true
+		}
+		ok, err := rr.next()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			heap[0] = heap[len(heap)-1]
+			heap = heap[:len(heap)-1]
+		}
+		down(0)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// key is a sort key as given to -k: POS1[,POS2], where POS is F[.C][OPTS].
+// Fields and characters are numbered from 1.
+type key struct {
+	startField, startChar int
+	// endField is 0 when the key extends to the end of the line.
+	// endChar is 0 when the key extends to the end of endField.
+	endField, endChar int
+	numeric, reverse  bool
+	// hasOpts is true if the key has its own ordering options, in which case
+	// the global -n and -r do not apply to it.
+	hasOpts bool
+}
+
+// keyList collects -k flags. It implements flag.Value.
+type keyList []key
+
+func (l *keyList) String() string {
+	return fmt.Sprint(*l)
+}
+
+func (l *keyList) Set(s string) error {
+	k, err := parseKey(s)
+	if err != nil {
+		return err
+	}
+	*l = append(*l, k)
+	return nil
+}
+
+func parseKey(s string) (key, error) {
+	var k key
+	start, end, hasEnd := strings.Cut(s, ",")
+
+	var err error
+	if k.startField, k.startChar, err = parsePos(&k, start); err != nil {
+		return k, fmt.Errorf("invalid key %q: %v", s, err)
+	}
+	if k.startField < 1 || k.startChar < 0 {
+		return k, fmt.Errorf("invalid key %q: field and character numbers start at 1", s)
+	}
+	if k.startChar == 0 {
+		k.startChar = 1
+	}
+	if hasEnd {
+		if k.endField, k.endChar, err = parsePos(&k, end); err != nil {
+			return k, fmt.Errorf("invalid key %q: %v", s, err)
+		}
+		if k.endField < 1 || k.endChar < 0 {
+			return k, fmt.Errorf("invalid key %q: field and character numbers start at 1", s)
+		}
+	}
+	return k, nil
+}
+
+// parsePos parses F[.C][OPTS] and records any options in k.
+func parsePos(k *key, s string) (field, char int, err error) {
+	num := strings.TrimRight(s, "nr")
+	for _, opt := range s[len(num):] {
+		k.hasOpts = true
+		switch opt {
+		case 'n':
+			k.numeric = true
+		case 'r':
+			k.reverse = true
+		}
+	}
+	f, c, hasChar := strings.Cut(num, ".")
+	if field, err = strconv.Atoi(f); err != nil {
+		return 0, 0, fmt.Errorf("bad field number %q", f)
+	}
+	if hasChar {
+		if char, err = strconv.Atoi(c); err != nil {
+			return 0, 0, fmt.Errorf("bad character number %q", c)
+		}
+	}
+	return field, char, nil
+}
+
+func isBlank(c byte) bool {
+	return c == ' ' || c == '\t'
+}
+
+// fields returns the start and end offsets of the fields of line.
+// With an empty separator, fields are separated by the empty string between
+// a non-blank and a blank character, so leading blanks belong to the field.
+func fields(line, sep string) [][2]int {
+	var f [][2]int
+	if sep != "" {
+		start := 0
+		for {
+			i := strings.Index(line[start:], sep)
+			if i < 0 {
+				return append(f, [2]int{start, len(line)})
+			}
+			f = append(f, [2]int{start, start + i})
+			start += i + len(sep)
+		}
+	}
+	start := 0
+	for start < len(line) {
+		i := start
+		for i < len(line) && isBlank(line[i]) {
+			i++
+		}
+		for i < len(line) && !isBlank(line[i]) {
+			i++
+		}
+		f = append(f, [2]int{start, i})
+		start = i
+	}
+	return f
+}
+
+// extract returns the part of line selected by k.
+func (k key) extract(line, sep string) string {
+	f := fields(line, sep)
+
+	start := len(line)
+	if k.startField <= len(f) {
+		field := f[k.startField-1]
+		start = min(field[0]+k.startChar-1, field[1])
+	}
+
+	end := len(line)
+	if k.endField > 0 && k.endField <= len(f) {
+		field := f[k.endField-1]
+		end = field[1]
+		if k.endChar > 0 {
+			end = min(field[0]+k.endChar, field[1])
+		}
+	}
+
+	if end < start {
+		return ""
+	}
+	return line[start:end]
+}
+
+// parseNumber returns the value of the number at the start of s, ignoring
+// leading blanks. A string that does not start with a number is 0.
+func parseNumber(s string) float64 {
+	i := 0
+	for i < len(s) && isBlank(s[i]) {
+		i++
+	}
+	start := i
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+	}
+	v, err := strconv.ParseFloat(s[start:i], 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
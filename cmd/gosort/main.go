@@ -0,0 +1,279 @@
+// Command gosort sorts lines of text files, like sort(1), using one of the
+// sorting algorithms of this repository.
+//
+// Usage:
+//
+//	gosort [flags] [file ...]
+//
+// It supports the commonly used flags of sort(1): -n, -r, -u, -s, -k, -t and
+// -o, and single letter flags can be combined as in -nr or -k2,2n. The
+// -algorithm flag selects any algorithm from the registry of the sort
+// package. Input larger than the -S memory limit is sorted in chunks that
+// are written to temporary files and merged, at most 16 files at a time.
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	sort "github.com/quasoft/goalgorithms/sort"
+)
+
+// options holds the parsed command line flags.
+type options struct {
+	numeric, reverse bool
+	unique, stable   bool
+	keys             keyList
+	sep              string
+	output           string
+	algorithm        sort.Algorithm
+	memory           int64
+	tempDir          string
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "gosort:", err)
+		os.Exit(2)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	opts, files, err := parseFlags(args)
+	if err != nil {
+		return err
+	}
+
+	inputs, closeInputs, err := openInputs(files, stdin)
+	if err != nil {
+		return err
+	}
+	defer closeInputs()
+
+	s := newSorter(opts)
+	runs, err := s.sortChunks(inputs)
+	if err != nil {
+		return err
+	}
+	defer runs.remove()
+
+	// The output file is only opened after all input has been read,
+	// so that it may be one of the input files.
+	out := stdout
+	if opts.output != "" {
+		f, err := os.Create(opts.output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	w := bufio.NewWriter(out)
+	if err := s.merge(runs, w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func parseFlags(args []string) (*options, []string, error) {
+	opts := &options{}
+	fs := flag.NewFlagSet("gosort", flag.ContinueOnError)
+	fs.BoolVar(&opts.numeric, "n", false, "compare according to numerical value")
+	fs.BoolVar(&opts.reverse, "r", false, "reverse the result of comparisons")
+	fs.BoolVar(&opts.unique, "u", false, "output only the first of lines with equal keys")
+	fs.BoolVar(&opts.stable, "s", false, "stable sort: do not compare whole lines as a last resort")
+	fs.Var(&opts.keys, "k", "sort by key `POS1[,POS2]`, where POS is F[.C][OPTS] and OPTS are n and r")
+	fs.StringVar(&opts.sep, "t", "", "use `SEP` instead of the non-blank to blank transition as field separator")
+	fs.StringVar(&opts.output, "o", "", "write result to `FILE` instead of standard output")
+	algorithm := fs.String("algorithm", "MergeSortBottomUp2", "sorting `ALGORITHM` to use, one of:\n"+algorithmNames())
+	memory := fs.String("S", "64M", "use `SIZE` bytes of memory before sorting in chunks, with an optional K, M or G suffix")
+	fs.StringVar(&opts.tempDir, "T", "", "use `DIR` for temporary files")
+	if err := fs.Parse(expandArgs(args)); err != nil {
+		return nil, nil, err
+	}
+
+	var ok bool
+	if opts.algorithm, ok = sort.LookupAlgorithm(*algorithm); !ok {
+		return nil, nil, fmt.Errorf("unknown algorithm %q", *algorithm)
+	}
+	if opts.algorithm.SortFunc == nil {
+		return nil, nil, fmt.Errorf("algorithm %s cannot sort lines, as it does not sort by comparison", opts.algorithm.Name)
+	}
+	var err error
+	if opts.memory, err = parseSize(*memory); err != nil {
+		return nil, nil, err
+	}
+	return opts, fs.Args(), nil
+}
+
+func algorithmNames() string {
+	var names []string
+	for _, a := range sort.Algorithms() {
+		if a.SortFunc != nil {
+			names = append(names, a.Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// expandArgs splits combined single letter flags like -nr into -n -r and
+// flags with attached values like -k2,2 or -t, into -k 2,2 and -t ,
+// so that they can be parsed by the flag package.
+func expandArgs(args []string) []string {
+	const boolFlags = "nrus"
+	const valueFlags = "ktoST"
+	var expanded []string
+	for i, arg := range args {
+		if arg == "--" || !strings.HasPrefix(arg, "-") || strings.HasPrefix(arg, "--") || len(arg) < 3 ||
+			!strings.ContainsRune(boolFlags+valueFlags, rune(arg[1])) {
+			if arg == "--" {
+				return append(expanded, args[i:]...)
+			}
+			expanded = append(expanded, arg)
+			continue
+		}
+		for j := 1; j < len(arg); j++ {
+			c := arg[j]
+			if strings.IndexByte(valueFlags, c) >= 0 {
+				expanded = append(expanded, "-"+string(c))
+				if j+1 < len(arg) {
+					expanded = append(expanded, arg[j+1:])
+				}
+				break
+			}
+			expanded = append(expanded, "-"+string(c))
+		}
+	}
+	return expanded
+}
+
+// parseSize parses a byte count with an optional K, M or G suffix.
+func parseSize(s string) (int64, error) {
+	mult := int64(1)
+	switch {
+	case strings.HasSuffix(s, "K"):
+		mult = 1 << 10
+	case strings.HasSuffix(s, "M"):
+		mult = 1 << 20
+	case strings.HasSuffix(s, "G"):
+		mult = 1 << 30
+	}
+	if mult > 1 {
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid memory size %q", s)
+	}
+	return n * mult, nil
+}
+
+// openInputs opens all files for reading, or returns stdin if there are
+// none. A file named "-" also stands for stdin.
+func openInputs(files []string, stdin io.Reader) ([]io.Reader, func(), error) {
+	if len(files) == 0 {
+		return []io.Reader{stdin}, func() {}, nil
+	}
+	var readers []io.Reader
+	var opened []*os.File
+	closeAll := func() {
+		for _, f := range opened {
+			f.Close()
+		}
+	}
+	for _, name := range files {
+		if name == "-" {
+			readers = append(readers, stdin)
+			continue
+		}
+		f, err := os.Open(name)
+		if err != nil {
+			closeAll()
+			return nil, nil, err
+		}
+		opened = append(opened, f)
+		readers = append(readers, f)
+	}
+	return readers, closeAll, nil
+}
+
+// sorter compares lines according to the options.
+type sorter struct {
+	opts *options
+}
+
+func newSorter(opts *options) *sorter {
+	return &sorter{opts: opts}
+}
+
+// compareKeys compares the keys of two lines. Lines with equal keys are
+// considered duplicates by -u.
+func (s *sorter) compareKeys(a, b string) int {
+	if len(s.opts.keys) == 0 {
+		return s.compareField(a, b, s.opts.numeric, s.opts.reverse)
+	}
+	for _, k := range s.opts.keys {
+		numeric, reverse := s.opts.numeric, s.opts.reverse
+		if k.hasOpts {
+			numeric, reverse = k.numeric, k.reverse
+		}
+		if c := s.compareField(k.extract(a, s.opts.sep), k.extract(b, s.opts.sep), numeric, reverse); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func (s *sorter) compareField(a, b string, numeric, reverse bool) int {
+	var c int
+	if numeric {
+		c = cmp.Compare(parseNumber(a), parseNumber(b))
+	} else {
+		c = strings.Compare(a, b)
+	}
+	if reverse {
+		return -c
+	}
+	return c
+}
+
+// compare compares two lines by their keys and, unless -s or -u is given,
+// by the whole line as a last resort.
+func (s *sorter) compare(a, b string) int {
+	if c := s.compareKeys(a, b); c != 0 || s.opts.stable || s.opts.unique {
+		return c
+	}
+	if s.opts.reverse {
+		return strings.Compare(b, a)
+	}
+	return strings.Compare(a, b)
+}
+
+// sortLines sorts lines in-place with the selected algorithm. Lines that
+// compare equal keep their input order, whether the algorithm is stable or not.
+func (s *sorter) sortLines(lines []string) []string {
+	idx := make([]int, len(lines))
+	for i := range idx {
+		idx[i] = i
+	}
+	s.opts.algorithm.SortFunc(idx, func(i, j int) int {
+		if c := s.compare(lines[i], lines[j]); c != 0 {
+			return c
+		}
+		return cmp.Compare(i, j)
+	})
+	sorted := make([]string, 0, len(lines))
+	for _, i := range idx {
+		if s.opts.unique && len(sorted) > 0 && s.compareKeys(sorted[len(sorted)-1], lines[i]) == 0 {
+			continue
+		}
+		sorted = append(sorted, lines[i])
+	}
+	return sorted
+}
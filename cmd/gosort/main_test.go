@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		golden string
+		args   []string
+	}{
+		{"words", []string{"testdata/words.txt"}},
+		{"words_reverse", []string{"-r", "testdata/words.txt"}},
+		{"words_unique", []string{"-u", "testdata/words.txt"}},
+		{"numbers", []string{"-n", "testdata/numbers.txt"}},
+		{"numbers_reverse", []string{"-nr", "testdata/numbers.txt"}},
+		{"numbers_unique", []string{"-n", "-u", "testdata/numbers.txt"}},
+		{"people_age", []string{"-t", ",", "-k3,3n", "testdata/people.csv"}},
+		{"people_age", []string{"-t,", "-k", "3,3n", "--algorithm=QuickSortLomuto", "testdata/people.csv"}},
+		{"people_city_name", []string{"-t,", "-k4,4", "-k1,2", "testdata/people.csv"}},
+		{"people_city_stable", []string{"-s", "-t,", "-k4,4", "testdata/people.csv"}},
+		{"people_city_stable", []string{"-s", "-t,", "-k4,4", "-algorithm", "SelectionSort", "testdata/people.csv"}},
+		{"people_age_desc_unique", []string{"-u", "-t,", "-k3,3nr", "testdata/people.csv"}},
+		{"people_second_char", []string{"-t,", "-k2.2,2.2", "-k1,1", "testdata/people.csv"}},
+		{"scores", []string{"-k2n", "testdata/scores.txt"}},
+		{"scores_color", []string{"-s", "-k3", "testdata/scores.txt"}},
+		{"two_files", []string{"-n", "testdata/numbers.txt", "testdata/words.txt"}},
+	}
+	for _, tt := range tests {
+		golden := filepath.Join("testdata", tt.golden+".golden")
+		// Every case is also run with a memory limit small enough to force
+		// an external merge of several temporary files.
+		for _, args := range [][]string{tt.args, append([]string{"-S", "64"}, tt.args...)} {
+			t.Run(strings.Join(args, " "), func(t *testing.T) {
+				var out bytes.Buffer
+				if err := run(args, strings.NewReader(""), &out); err != nil {
+					t.Fatalf("run(%v) failed: %v", args, err)
+				}
+				if *update {
+					if err := os.WriteFile(golden, out.Bytes(), 0644); err != nil {
+						t.Fatal(err)
+					}
+					return
+				}
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := out.String(); got != string(want) {
+					t.Errorf("run(%v) =\n%s\nwant\n%s", args, got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestRun_Stdin(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"-n"}, strings.NewReader("3\n1\n2"), &out); err != nil {
+		t.Fatalf("run() failed: %v", err)
+	}
+	if got, want := out.String(), "1\n2\n3\n"; got != want {
+		t.Errorf("run(-n) = %q, want %q", got, want)
+	}
+}
+
+// TestRun_ManyRuns sorts input that is split into many more runs than
+// mergeFanIn, so that groups of runs are merged into temporary files over
+// several passes before the final merge.
+func TestRun_ManyRuns(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	var in, unique, all strings.Builder
+	for _, v := range rnd.Perm(3000) {
+		// Every value appears twice.
+		fmt.Fprintf(&in, "%d\n", v/2)
+	}
+	for v := 0; v < 1500; v++ {
+		fmt.Fprintf(&unique, "%d\n", v)
+		fmt.Fprintf(&all, "%d\n%d\n", v, v)
+	}
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-n"}, all.String()},
+		{[]string{"-n", "-u"}, unique.String()},
+	}
+	for _, tt := range tests {
+		dir := t.TempDir()
+		args := append(tt.args, "-S", "64", "-T", dir)
+		var out bytes.Buffer
+		if err := run(args, strings.NewReader(in.String()), &out); err != nil {
+			t.Fatalf("run(%v) failed: %v", args, err)
+		}
+		if got := out.String(); got != tt.want {
+			t.Errorf("run(%v) returned %d bytes, want %d", args, len(got), len(tt.want))
+		}
+		if files, _ := os.ReadDir(dir); len(files) > 0 {
+			t.Errorf("run(%v) left %d temporary files", args, len(files))
+		}
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"Unknown algorithm", []string{"--algorithm=BogoSort"}},
+		{"Distribution sort", []string{"--algorithm=BucketSort"}},
+		{"Bad key", []string{"-k", "x"}},
+		{"Zero field", []string{"-k0"}},
+		{"Bad memory size", []string{"-S", "lots"}},
+		{"Missing file", []string{"testdata/missing.txt"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := run(tt.args, strings.NewReader(""), &bytes.Buffer{}); err == nil {
+				t.Errorf("run(%v) = nil, want error", tt.args)
+			}
+		})
+	}
+}
+
+func TestExpandArgs(t *testing.T) {
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"-nr", "file"}, []string{"-n", "-r", "file"}},
+		{[]string{"-k2,2n", "-t,"}, []string{"-k", "2,2n", "-t", ","}},
+		{[]string{"-nk3"}, []string{"-n", "-k", "3"}},
+		{[]string{"-algorithm=QuickSortHoare", "--algorithm", "x"}, []string{"-algorithm=QuickSortHoare", "--algorithm", "x"}},
+		{[]string{"-", "--", "-nr"}, []string{"-", "--", "-nr"}},
+	}
+	for _, tt := range tests {
+		if got := expandArgs(tt.args); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("expandArgs(%q) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestKey_Extract(t *testing.T) {
+	tests := []struct {
+		key  string
+		sep  string
+		line string
+		want string
+	}{
+		{"2", "", "a  b c", "  b c"},
+		{"2,2", "", "a  b c", "  b"},
+		{"2.2,2.3", "", "a  b c", " b"},
+		{"3", ",", "x,y,z,w", "z,w"},
+		{"3,3", ",", "x,y,z,w", "z"},
+		{"2.2,2.3", ",", "x,hello,z", "el"},
+		{"5", ",", "x,y", ""},
+		{"1,1", ",", ",y", ""},
+	}
+	for _, tt := range tests {
+		k, err := parseKey(tt.key)
+		if err != nil {
+			t.Fatalf("parseKey(%q) failed: %v", tt.key, err)
+		}
+		if got := k.extract(tt.line, tt.sep); got != tt.want {
+			t.Errorf("key %q with separator %q on %q = %q, want %q", tt.key, tt.sep, tt.line, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package goalgorithms
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// concurrentSkipNode is a node of a ConcurrentSkipList. Its links are atomic,
+// so that they can be read without locking, and the lock of the node guards
+// changes to them.
+type concurrentSkipNode struct {
+	data int
+	next []atomic.Pointer[concurrentSkipNode]
+	mu   sync.Mutex
+	// marked is set when the node is being removed from the list, and
+	// fullyLinked once it has been linked on all levels of its tower.
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+// ConcurrentSkipList is a sorted set of integers like SkipList, which is safe
+// for concurrent use by multiple goroutines. It is the lazy skip list of
+// Herlihy, Lev, Luchangco and Shavit:
+//   - Search takes no locks and never waits. It only reads links, which are
+//     atomic, and a node counts as an element once it is fully linked, until
+//     it is marked for removal.
+//   - Insert and Delete search for the nodes to change without locking, then
+//     lock them from the lowest level up and check that they have not
+//     changed in the meantime, retrying otherwise. This is optimistic
+//     locking: only the nodes around the change are locked, and only for as
+//     long as it takes to relink them.
+//
+// Removal first marks the node, which removes it from the set logically,
+// and then unlinks it from the top of its tower down.
+type ConcurrentSkipList struct {
+	head   *concurrentSkipNode
+	length atomic.Int64
+}
+
+// NewConcurrentSkipList creates a new empty concurrent skip list.
+func NewConcurrentSkipList() *ConcurrentSkipList {
+	head := &concurrentSkipNode{next: make([]atomic.Pointer[concurrentSkipNode], skipListMaxLevel)}
+	head.fullyLinked.Store(true)
+	return &ConcurrentSkipList{head: head}
+}
+
+// Len returns the number of elements in the list.
+func (l *ConcurrentSkipList) Len() int {
+	return int(l.length.Load())
+}
+
+// find fills preds with the last node before data on every level, and succs
+// with the node after it, and returns the highest level on which a node with
+// the value data was found, or -1 if there is none.
+func (l *ConcurrentSkipList) find(data int, preds, succs *[skipListMaxLevel]*concurrentSkipNode) int {
+	found := -1
+	pred := l.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && curr.data < data {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if found == -1 && curr != nil && curr.data == data {
+			found = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+	return found
+}
+
+// Search reports whether the list holds the given value.
+func (l *ConcurrentSkipList) Search(data int) bool {
+	var preds, succs [skipListMaxLevel]*concurrentSkipNode
+	found := l.find(data, &preds, &succs)
+	return found != -1 && succs[found].fullyLinked.Load() && !succs[found].marked.Load()
+}
+
+// lockPreds locks the distinct nodes of preds[:levels], which are ordered
+// from the lowest level up, and reports whether valid returns true for all
+// levels. If it does not, it unlocks them and returns false.
+func lockPreds(preds *[skipListMaxLevel]*concurrentSkipNode, levels int, valid func(level int) bool) bool {
+	for level := 0; level < levels; level++ {
+		if level == 0 || preds[level] != preds[level-1] {
+			preds[level].mu.Lock()
+		}
+		if !valid(level) {
+			unlockPreds(preds, level+1)
+			return false
+		}
+	}
+	return true
+}
+
+// unlockPreds unlocks the distinct nodes of preds[:levels].
+func unlockPreds(preds *[skipListMaxLevel]*concurrentSkipNode, levels int) {
+	for level := 0; level < levels; level++ {
+		if level == 0 || preds[level] != preds[level-1] {
+			preds[level].mu.Unlock()
+		}
+	}
+}
+
+// Insert adds the given value to the list, and reports whether it was not in
+// the list already.
+func (l *ConcurrentSkipList) Insert(data int) bool {
+	levels := randomLevel()
+	var preds, succs [skipListMaxLevel]*concurrentSkipNode
+	for {
+		if found := l.find(data, &preds, &succs); found != -1 {
+			node := succs[found]
+			if !node.marked.Load() {
+				// Another insert of the value is linking the node.
+				for !node.fullyLinked.Load() {
+					runtime.Gosched()
+				}
+				return false
+			}
+			// The node is being removed, so try again once it is gone.
+			continue
+		}
+		// The nodes around the new one must still be in the list and next to
+		// each other.
+		valid := func(level int) bool {
+			pred, succ := preds[level], succs[level]
+			return !pred.marked.Load() && (succ == nil || !succ.marked.Load()) && pred.next[level].Load() == succ
+		}
+		if !lockPreds(&preds, levels, valid) {
+			continue
+		}
+		node := &concurrentSkipNode{data: data, next: make([]atomic.Pointer[concurrentSkipNode], levels)}
+		for level := range node.next {
+			node.next[level].Store(succs[level])
+		}
+		for level := range node.next {
+			preds[level].next[level].Store(node)
+		}
+		node.fullyLinked.Store(true)
+		l.length.Add(1)
+		unlockPreds(&preds, levels)
+		return true
+	}
+}
+
+// Delete removes the given value from the list, and reports whether it was in
+// the list.
+func (l *ConcurrentSkipList) Delete(data int) bool {
+	var preds, succs [skipListMaxLevel]*concurrentSkipNode
+	var victim *concurrentSkipNode
+	for {
+		found := l.find(data, &preds, &succs)
+		if victim == nil {
+			// Only a node that is fully linked, and found on its top level,
+			// which means it is not being inserted, can be removed.
+			if found == -1 {
+				return false
+			}
+			node := succs[found]
+			if !node.fullyLinked.Load() || len(node.next)-1 != found || node.marked.Load() {
+				return false
+			}
+			node.mu.Lock()
+			if node.marked.Load() {
+				node.mu.Unlock()
+				return false
+			}
+			node.marked.Store(true)
+			victim = node
+		}
+		// The nodes before the victim must still be in the list and link to
+		// it. If they do not, find them again.
+		valid := func(level int) bool {
+			pred := preds[level]
+			return !pred.marked.Load() && pred.next[level].Load() == victim
+		}
+		if !lockPreds(&preds, len(victim.next), valid) {
+			continue
+		}
+		for level := len(victim.next) - 1; level >= 0; level-- {
+			preds[level].next[level].Store(victim.next[level].Load())
+		}
+		victim.mu.Unlock()
+		l.length.Add(-1)
+		unlockPreds(&preds, len(victim.next))
+		return true
+	}
+}
+
+// All returns an iterator over the values of the list, in ascending order.
+// It does not lock the list, and reflects some of the changes made while
+// iterating.
+func (l *ConcurrentSkipList) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for node := l.head.next[0].Load(); node != nil; node = node.next[0].Load() {
+			if node.fullyLinked.Load() && !node.marked.Load() && !yield(node.data) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package goalgorithms
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentSkipList(t *testing.T) {
+	list := NewConcurrentSkipList()
+	for _, v := range []int{5, 1, 3, 3, -2} {
+		list.Insert(v)
+	}
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{-2, 1, 3, 5}) {
+		t.Fatalf("All() = %v, want [-2 1 3 5]", got)
+	}
+	if !list.Search(3) || list.Search(4) {
+		t.Fatalf("Search(3), Search(4) = %v, %v, want true, false", list.Search(3), list.Search(4))
+	}
+	if !list.Delete(3) || list.Delete(3) || list.Search(3) {
+		t.Fatalf("Delete(3) did not remove 3 once")
+	}
+	if list.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", list.Len())
+	}
+}
+
+// TestConcurrentSkipList_Parallel runs a mixed workload on the list from
+// several goroutines. Each goroutine owns the values that are equal to its
+// number modulo the number of goroutines, so it knows which of them must be
+// in the list, while all goroutines search for all values. Run it with -race
+// to check that the list is free of data races.
+func TestConcurrentSkipList_Parallel(t *testing.T) {
+	const workers = 8
+	const values = 512
+	ops := 20000
+	if testing.Short() {
+		ops = 2000
+	}
+	list := NewConcurrentSkipList()
+	owned := make([]map[int]bool, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		owned[w] = map[int]bool{}
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			rnd := rand.New(rand.NewSource(int64(w)))
+			for i := 0; i < ops; i++ {
+				v := rnd.Intn(values/workers)*workers + w
+				switch rnd.Intn(4) {
+				case 0:
+					if got := list.Insert(v); got == owned[w][v] {
+						t.Errorf("Insert(%d) = %v, but value in list is %v", v, got, owned[w][v])
+						return
+					}
+					owned[w][v] = true
+				case 1:
+					if got := list.Delete(v); got != owned[w][v] {
+						t.Errorf("Delete(%d) = %v, want %v", v, got, owned[w][v])
+						return
+					}
+					delete(owned[w], v)
+				case 2:
+					if got := list.Search(v); got != owned[w][v] {
+						t.Errorf("Search(%d) = %v, want %v", v, got, owned[w][v])
+						return
+					}
+				default:
+					// Values of other goroutines may come and go.
+					list.Search(rnd.Intn(values))
+					prev := -1
+					for x := range list.All() {
+						if x <= prev {
+							t.Errorf("All() returned %d after %d", x, prev)
+							return
+						}
+						prev = x
+						if x > v {
+							break
+						}
+					}
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	var want []int
+	for w := range owned {
+		for v := range owned[w] {
+			want = append(want, v)
+		}
+	}
+	slices.Sort(want)
+	if got := slices.Collect(list.All()); !slices.Equal(got, want) {
+		t.Fatalf("All() = %v, want %v", got, want)
+	}
+	if list.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", list.Len(), len(want))
+	}
+}
+
+func BenchmarkConcurrentSkipList(b *testing.B) {
+	list := NewConcurrentSkipList()
+	for i := 0; i < 100000; i += 2 {
+		list.Insert(i)
+	}
+	b.RunParallel(func(pb *testing.PB) {
+		rnd := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			v := rnd.Intn(100000)
+			switch rnd.Intn(10) {
+			case 0:
+				list.Insert(v)
+			case 1:
+				list.Delete(v)
+			default:
+				list.Search(v)
+			}
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package goalgorithms
+
+import (
+	"iter"
+	"math/bits"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// skipListMaxLevel is the highest tower of a skip list. With a probability of
+// 1/4 for every level above the first, it suits lists of up to 4^16 elements.
+const skipListMaxLevel = 16
+
+// randomLevel returns the height of the tower of a new skip list node. It is
+// at least 1, and every pair of random zero bits adds a level, so the
+// probability of a level is 1/4 of that of the level below it.
+func randomLevel() int {
+	return 1 + bits.TrailingZeros64(rand.Uint64()|1<<(2*(skipListMaxLevel-1)))/2
+}
+
+// SkipNode represents an element in a skip list with value (data) and pointers
+// (next) to the next element on every level of its tower.
+type SkipNode struct {
+	data int
+	next []*SkipNode
+}
+
+// NewSkipNode creates a new node object with the given value and a tower of
+// the given number of levels.
+func NewSkipNode(data int, levels int) *SkipNode {
+	return &SkipNode{data: data, next: make([]*SkipNode, levels)}
+}
+
+func (n SkipNode) String() string {
+	return strconv.Itoa(n.data)
+}
+
+// SkipList represents a sorted set of integers, stored as a linked list of
+// SkipNode elements in ascending order. Besides the link to the next element,
+// every node has a tower of a random height, with links to the next node that
+// is at least as high on every level. A search starts on the highest level of
+// the head and drops a level whenever the next node on the current level
+// would pass the value, so it skips over most nodes.
+// Insert, Delete and Search take O(log(n)) expected time.
+type SkipList struct {
+	// head is a sentinel node before the first element, with a tower of the
+	// maximum height.
+	head   *SkipNode
+	level  int
+	length int
+}
+
+// NewSkipList creates a new empty skip list.
+func NewSkipList() *SkipList {
+	return &SkipList{head: NewSkipNode(0, skipListMaxLevel), level: 1}
+}
+
+// NewSkipListFromArray creates a new skip list and initializes it with the
+// values from an integer array.
+func NewSkipListFromArray(values ...int) *SkipList {
+	list := NewSkipList()
+	for _, v := range values {
+		list.Insert(v)
+	}
+	return list
+}
+
+func (l *SkipList) String() string {
+	var sb strings.Builder
+	for node := l.head.next[0]; node != nil; node = node.next[0] {
+		if sb.Len() > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(node.String())
+	}
+	return "[" + sb.String() + "]"
+}
+
+// Len returns the number of elements in the skip list.
+func (l *SkipList) Len() int {
+	return l.length
+}
+
+// findPreds fills preds with the last node before data on every level of the
+// list, and returns the node after it on the lowest level.
+func (l *SkipList) findPreds(data int, preds *[skipListMaxLevel]*SkipNode) *SkipNode {
+	node := l.head
+	for level := l.level - 1; level >= 0; level-- {
+		for node.next[level] != nil && node.next[level].data < data {
+			node = node.next[level]
+		}
+		preds[level] = node
+	}
+	return node.next[0]
+}
+
+// Search returns the node with the given value, or nil if there is none.
+func (l *SkipList) Search(data int) *SkipNode {
+	node := l.head
+	for level := l.level - 1; level >= 0; level-- {
+		for node.next[level] != nil && node.next[level].data < data {
+			node = node.next[level]
+		}
+	}
+	if node = node.next[0]; node != nil && node.data == data {
+		return node
+	}
+	return nil
+}
+
+// Insert adds an element with the given value to the skip list, and returns
+// the new node, or nil if the value was already in the list.
+func (l *SkipList) Insert(data int) *SkipNode {
+	var preds [skipListMaxLevel]*SkipNode
+	if next := l.findPreds(data, &preds); next != nil && next.data == data {
+		return nil
+	}
+	newNode := NewSkipNode(data, randomLevel())
+	for level := l.level; level < len(newNode.next); level++ {
+		preds[level] = l.head
+	}
+	l.level = max(l.level, len(newNode.next))
+	for level := range newNode.next {
+		newNode.next[level] = preds[level].next[level]
+		preds[level].next[level] = newNode
+	}
+	l.length++
+	return newNode
+}
+
+// Delete removes the element with the given value from the skip list, and
+// reports whether it was in the list.
+func (l *SkipList) Delete(data int) bool {
+	var preds [skipListMaxLevel]*SkipNode
+	node := l.findPreds(data, &preds)
+	if node == nil || node.data != data {
+		return false
+	}
+	for level := range node.next {
+		preds[level].next[level] = node.next[level]
+	}
+	for l.level > 1 && l.head.next[l.level-1] == nil {
+		l.level--
+	}
+	l.length--
+	return true
+}
+
+// All returns an iterator over the values of the skip list, in ascending
+// order.
+func (l *SkipList) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for node := l.head.next[0]; node != nil; node = node.next[0] {
+			if !yield(node.data) {
+				return
+			}
+		}
+	}
+}
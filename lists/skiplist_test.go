@@ -0,0 +1,145 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkSkipList returns an error if the levels of l are not sorted, or a node
+// is missing from a level below one that it is on.
+func checkSkipList(l *SkipList) error {
+	for level := 0; level < skipListMaxLevel; level++ {
+		below := l.head
+		for node := l.head.next[level]; node != nil; node = node.next[level] {
+			if level >= l.level {
+				return fmt.Errorf("node %v is on level %d, above the level of the list %d", node, level, l.level)
+			}
+			if node.next[level] != nil && node.next[level].data <= node.data {
+				return fmt.Errorf("node %v is followed by %v on level %d", node, node.next[level], level)
+			}
+			if level > 0 {
+				for below != nil && below != node {
+					below = below.next[level-1]
+				}
+				if below == nil {
+					return fmt.Errorf("node %v is on level %d, but not on level %d", node, level, level-1)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func TestNewSkipList(t *testing.T) {
+	list := NewSkipList()
+	if list == nil {
+		t.Fatalf("NewSkipList() = nil, expected a struct")
+	}
+	if got := list.String(); got != "[]" {
+		t.Errorf("NewSkipList().String() = %v, want []", got)
+	}
+}
+
+func TestSkipList(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		insert int
+		delete int
+		want   string
+	}{
+		{"Insert into empty", nil, 1, 2, "[1]"},
+		{"Insert at start", []int{2, 3}, 1, 4, "[1, 2, 3]"},
+		{"Insert in middle", []int{3, 1}, 2, 4, "[1, 2, 3]"},
+		{"Insert existing", []int{1, 2}, 2, 4, "[1, 2]"},
+		{"Delete first", []int{1, 2, 3}, 3, 1, "[2, 3]"},
+		{"Delete last", []int{1, 2, 3}, 3, 3, "[1, 2]"},
+		{"Delete only", []int{5}, 5, 5, "[]"},
+		{"Negative values", []int{0, -5, 5}, -10, 0, "[-10, -5, 5]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewSkipListFromArray(tt.values...)
+			node := list.Insert(tt.insert)
+			if existing := slices.Contains(tt.values, tt.insert); (node == nil) != existing {
+				t.Errorf("Insert(%d) = %v, but value in list is %v", tt.insert, node, existing)
+			}
+			if got := list.Search(tt.insert); got == nil || got.data != tt.insert {
+				t.Errorf("Search(%d) = %v after Insert", tt.insert, got)
+			}
+			list.Delete(tt.delete)
+			if got := list.Search(tt.delete); got != nil {
+				t.Errorf("Search(%d) = %v after Delete, want nil", tt.delete, got)
+			}
+			if got := list.String(); got != tt.want {
+				t.Errorf("SkipList.String() = %v, want %v", got, tt.want)
+			}
+			if err := checkSkipList(list); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSkipList_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	list := NewSkipList()
+	want := map[int]bool{}
+	for i := 0; i < 20000; i++ {
+		v := rnd.Intn(1000)
+		switch rnd.Intn(3) {
+		case 0:
+			if got := list.Insert(v) != nil; got != !want[v] {
+				t.Fatalf("Insert(%d) added %v, want %v", v, got, !want[v])
+			}
+			want[v] = true
+		case 1:
+			if got := list.Delete(v); got != want[v] {
+				t.Fatalf("Delete(%d) = %v, want %v", v, got, want[v])
+			}
+			delete(want, v)
+		default:
+			if got := list.Search(v) != nil; got != want[v] {
+				t.Fatalf("Search(%d) found %v, want %v", v, got, want[v])
+			}
+		}
+		if i%100 == 0 {
+			if err := checkSkipList(list); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	var wantValues []int
+	for v := range want {
+		wantValues = append(wantValues, v)
+	}
+	slices.Sort(wantValues)
+	if got := slices.Collect(list.All()); !slices.Equal(got, wantValues) {
+		t.Fatalf("All() = %v, want %v", got, wantValues)
+	}
+	if list.Len() != len(wantValues) {
+		t.Fatalf("Len() = %d, want %d", list.Len(), len(wantValues))
+	}
+}
+
+func BenchmarkSkipList_Search(b *testing.B) {
+	// Building a LinkedList takes O(n^2) time, as Add walks to the end.
+	for _, n := range []int{1000, 10000} {
+		values := rand.New(rand.NewSource(1)).Perm(n)
+		skipList := NewSkipListFromArray(values...)
+		linkedList := NewLinkedListFromArray(values...)
+		b.Run(fmt.Sprintf("SkipList_%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				skipList.Search(values[i*7919%n])
+			}
+		})
+		b.Run(fmt.Sprintf("LinkedList_%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for node := linkedList.head; node != nil && node.data != values[i*7919%n]; node = node.next {
+				}
+			}
+		})
+	}
+}
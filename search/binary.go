@@ -24,19 +24,13 @@ func BinarySearchRecursive(needle int, haystack []int) (int, bool) {
 // BinarySearchLinear performs a binary search for needle in haystack
 // using iteration. Takes O(log(n)) time.
 // Slightly faster than recursive variant and uses less memory.
+// With repeated values, the index of the first occurrence is returned.
 func BinarySearchLinear(needle int, haystack []int) (int, bool) {
-	left := 0
-	right := len(haystack)
-	for right > left {
-		middle := left + (right-left)/2
-
-		if needle < haystack[middle] {
-			right = middle
-		} else if needle > haystack[middle] {
-			left = middle + 1
-		} else {
-			return middle, true
-		}
+	i := SearchPredicate(0, len(haystack), func(i int) bool {
+		return haystack[i] >= needle
+	})
+	if i < len(haystack) && haystack[i] == needle {
+		return i, true
 	}
 	return 0, false
 }
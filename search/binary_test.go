@@ -7,13 +7,6 @@ import (
 )
 
 func TestBinarySearch(t *testing.T) {
-	implementations := []struct {
-		name   string
-		search func(needle int, haystack []int) (int, bool)
-	}{
-		{"BinarySearchRecursive", BinarySearchRecursive},
-		{"BinarySearchLinear", BinarySearchLinear},
-	}
 	tests := []struct {
 		name     string
 		haystack []int
@@ -32,7 +25,7 @@ func TestBinarySearch(t *testing.T) {
 		{"Repeating values", []int{24, 24, 24, 33, 42, 42, 42}, 33, 3, true},
 		{"Close non existing value", []int{24, 24, 24, 33, 42, 42, 42}, 32, 0, false},
 	}
-	for _, impl := range implementations {
+	for _, impl := range searchImplementations {
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
 				gotIdx, gotOK := impl.search(tt.needle, tt.haystack)
@@ -0,0 +1,31 @@
+package goalgorithms
+
+// BitonicPeak returns the index of the largest value of the bitonic array
+// haystack, which is strictly increasing up to the peak and strictly
+// decreasing after it. Either part may be empty. Takes O(log(n)) time.
+func BitonicPeak(haystack []int) int {
+	return SearchPredicate(0, max(len(haystack)-1, 0), func(i int) bool {
+		return haystack[i] > haystack[i+1]
+	})
+}
+
+// BitonicSearch performs a binary search for needle in the bitonic array
+// haystack, like BitonicPeak expects. It finds the peak and binary searches
+// the increasing part before it and the decreasing part after it.
+// Takes O(log(n)) time.
+func BitonicSearch(needle int, haystack []int) (int, bool) {
+	if len(haystack) == 0 {
+		return 0, false
+	}
+	peak := BitonicPeak(haystack)
+	if i, ok := BinarySearchLinear(needle, haystack[:peak+1]); ok {
+		return i, true
+	}
+	i := SearchPredicate(peak+1, len(haystack), func(i int) bool {
+		return haystack[i] <= needle
+	})
+	if i < len(haystack) && haystack[i] == needle {
+		return i, true
+	}
+	return 0, false
+}
@@ -0,0 +1,92 @@
+package goalgorithms
+
+import "cmp"
+
+// LowerBound returns the index of the first value in haystack that is not
+// smaller than needle, or len(haystack) if there is none.
+// With repeated values, this is the first occurrence of needle.
+// Takes O(log(n)) time.
+func LowerBound(needle int, haystack []int) int {
+	return LowerBoundOrdered(needle, haystack)
+}
+
+// UpperBound returns the index of the first value in haystack that is greater
+// than needle, or len(haystack) if there is none.
+// With repeated values, this is one past the last occurrence of needle.
+// Takes O(log(n)) time.
+func UpperBound(needle int, haystack []int) int {
+	return UpperBoundOrdered(needle, haystack)
+}
+
+// EqualRange returns the range haystack[first:last] of values equal to needle.
+// If there is none, the range is empty and first is where needle would be
+// inserted. Takes O(log(n)) time.
+func EqualRange(needle int, haystack []int) (first, last int) {
+	return EqualRangeOrdered(needle, haystack)
+}
+
+// InsertionPoint performs a binary search for needle in haystack like
+// BinarySearchLinear, but with repeated values returns the index of the first
+// occurrence, and if needle is not found, returns the index where it would
+// have to be inserted to keep haystack sorted. Takes O(log(n)) time.
+func InsertionPoint(needle int, haystack []int) (int, bool) {
+	return InsertionPointOrdered(needle, haystack)
+}
+
+// LowerBoundOrdered is a generic variant of LowerBound. Values are ordered
+// like cmp.Compare does, so a NaN needle is found before all other values.
+func LowerBoundOrdered[S ~[]E, E cmp.Ordered](needle E, haystack S) int {
+	return SearchPredicate(0, len(haystack), func(i int) bool {
+		return !cmp.Less(haystack[i], needle)
+	})
+}
+
+// UpperBoundOrdered is a generic variant of UpperBound.
+func UpperBoundOrdered[S ~[]E, E cmp.Ordered](needle E, haystack S) int {
+	return SearchPredicate(0, len(haystack), func(i int) bool {
+		return cmp.Less(needle, haystack[i])
+	})
+}
+
+// EqualRangeOrdered is a generic variant of EqualRange.
+func EqualRangeOrdered[S ~[]E, E cmp.Ordered](needle E, haystack S) (first, last int) {
+	first = LowerBoundOrdered(needle, haystack)
+	last = first + UpperBoundOrdered(needle, haystack[first:])
+	return first, last
+}
+
+// InsertionPointOrdered is a generic variant of InsertionPoint.
+func InsertionPointOrdered[S ~[]E, E cmp.Ordered](needle E, haystack S) (int, bool) {
+	i := LowerBoundOrdered(needle, haystack)
+	return i, i < len(haystack) && cmp.Compare(needle, haystack[i]) == 0
+}
+
+// LowerBoundFunc is a variant of LowerBound for haystacks of any type, sorted
+// in ascending order as determined by cmp. cmp(e, needle) must return a
+// negative number if e comes before needle, zero if it matches and a positive
+// number if it comes after.
+func LowerBoundFunc[S ~[]E, E, T any](needle T, haystack S, cmp func(E, T) int) int {
+	return SearchPredicate(0, len(haystack), func(i int) bool {
+		return cmp(haystack[i], needle) >= 0
+	})
+}
+
+// UpperBoundFunc is a variant of UpperBound that uses cmp like LowerBoundFunc.
+func UpperBoundFunc[S ~[]E, E, T any](needle T, haystack S, cmp func(E, T) int) int {
+	return SearchPredicate(0, len(haystack), func(i int) bool {
+		return cmp(haystack[i], needle) > 0
+	})
+}
+
+// EqualRangeFunc is a variant of EqualRange that uses cmp like LowerBoundFunc.
+func EqualRangeFunc[S ~[]E, E, T any](needle T, haystack S, cmp func(E, T) int) (first, last int) {
+	first = LowerBoundFunc(needle, haystack, cmp)
+	last = first + UpperBoundFunc(needle, haystack[first:], cmp)
+	return first, last
+}
+
+// InsertionPointFunc is a variant of InsertionPoint that uses cmp like LowerBoundFunc.
+func InsertionPointFunc[S ~[]E, E, T any](needle T, haystack S, cmp func(E, T) int) (int, bool) {
+	i := LowerBoundFunc(needle, haystack, cmp)
+	return i, i < len(haystack) && cmp(haystack[i], needle) == 0
+}
@@ -0,0 +1,113 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBounds(t *testing.T) {
+	haystack := []int{3, 3, 3, 5, 7, 7, 7, 7, 9, 12, 12}
+	tests := []struct {
+		name      string
+		haystack  []int
+		needle    int
+		wantFirst int
+		wantLast  int
+	}{
+		{"Empty", nil, 3, 0, 0},
+		{"Single equal", []int{3}, 3, 0, 1},
+		{"All equal", []int{4, 4, 4, 4}, 4, 0, 4},
+		{"Run at the start", haystack, 3, 0, 3},
+		{"Single in the middle", haystack, 5, 3, 4},
+		{"Run in the middle", haystack, 7, 4, 8},
+		{"Run at the end", haystack, 12, 9, 11},
+		{"Smaller than all", haystack, 1, 0, 0},
+		{"Between runs", haystack, 6, 4, 4},
+		{"Greater than all", haystack, 20, 11, 11},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LowerBound(tt.needle, tt.haystack); got != tt.wantFirst {
+				t.Errorf("LowerBound(%v, %v) = %v, want %v", tt.needle, tt.haystack, got, tt.wantFirst)
+			}
+			if got := UpperBound(tt.needle, tt.haystack); got != tt.wantLast {
+				t.Errorf("UpperBound(%v, %v) = %v, want %v", tt.needle, tt.haystack, got, tt.wantLast)
+			}
+			if first, last := EqualRange(tt.needle, tt.haystack); first != tt.wantFirst || last != tt.wantLast {
+				t.Errorf("EqualRange(%v, %v) = %v, %v, want %v, %v", tt.needle, tt.haystack, first, last, tt.wantFirst, tt.wantLast)
+			}
+			wantOK := tt.wantLast > tt.wantFirst
+			if idx, ok := InsertionPoint(tt.needle, tt.haystack); idx != tt.wantFirst || ok != wantOK {
+				t.Errorf("InsertionPoint(%v, %v) = %v, %v, want %v, %v", tt.needle, tt.haystack, idx, ok, tt.wantFirst, wantOK)
+			}
+		})
+	}
+}
+
+func TestBounds_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		haystack := make([]int, rnd.Intn(40))
+		for j := range haystack {
+			haystack[j] = rnd.Intn(10)
+		}
+		slices.Sort(haystack)
+		needle := rnd.Intn(12) - 1
+
+		// Find the range of needle with a linear scan.
+		first := 0
+		for first < len(haystack) && haystack[first] < needle {
+			first++
+		}
+		last := first
+		for last < len(haystack) && haystack[last] == needle {
+			last++
+		}
+
+		if got, gotLast := EqualRange(needle, haystack); got != first || gotLast != last {
+			t.Fatalf("EqualRange(%v, %v) = %v, %v, want %v, %v", needle, haystack, got, gotLast, first, last)
+		}
+		byValue := func(e, needle int) int { return cmp.Compare(e, needle) }
+		if got, gotLast := EqualRangeFunc(needle, haystack, byValue); got != first || gotLast != last {
+			t.Fatalf("EqualRangeFunc(%v, %v) = %v, %v, want %v, %v", needle, haystack, got, gotLast, first, last)
+		}
+		if got, ok := InsertionPointFunc(needle, haystack, byValue); got != first || ok != (last > first) {
+			t.Fatalf("InsertionPointFunc(%v, %v) = %v, %v, want %v, %v", needle, haystack, got, ok, first, last > first)
+		}
+	}
+}
+
+func TestBoundsOrdered(t *testing.T) {
+	words := []string{"apple", "fig", "fig", "fig", "kiwi", "pear"}
+	if first, last := EqualRangeOrdered("fig", words); first != 1 || last != 4 {
+		t.Errorf("EqualRangeOrdered(fig, %v) = %v, %v, want 1, 4", words, first, last)
+	}
+	if idx, ok := InsertionPointOrdered("grape", words); idx != 4 || ok {
+		t.Errorf("InsertionPointOrdered(grape, %v) = %v, %v, want 4, false", words, idx, ok)
+	}
+
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{{"Ann", 20}, {"Bob", 31}, {"Cid", 31}, {"Dee", 31}, {"Eve", 45}}
+	byAge := func(p person, age int) int { return cmp.Compare(p.age, age) }
+	if first, last := EqualRangeFunc(31, people, byAge); first != 1 || last != 4 {
+		t.Errorf("EqualRangeFunc(31, %v) = %v, %v, want 1, 4", people, first, last)
+	}
+	if got := UpperBoundFunc(31, people, byAge); got != 4 {
+		t.Errorf("UpperBoundFunc(31, %v) = %v, want 4", people, got)
+	}
+	byPrefix := func(w, prefix string) int {
+		if strings.HasPrefix(w, prefix) {
+			return 0
+		}
+		return strings.Compare(w, prefix)
+	}
+	if first, last := EqualRangeFunc("fi", words, byPrefix); first != 1 || last != 4 {
+		t.Errorf("EqualRangeFunc(fi, %v) by prefix = %v, %v, want 1, 4", words, first, last)
+	}
+}
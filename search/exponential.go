@@ -0,0 +1,45 @@
+package goalgorithms
+
+// ExponentialSearch performs an exponential (galloping) search for needle in
+// haystack. It probes positions 0, 1, 3, 7, 15, ... until it passes needle and
+// then binary searches between the last two probes.
+// Takes O(log(i)) time, where i is the position of needle, so it is faster
+// than binary search when needles tend to be near the start.
+func ExponentialSearch(needle int, haystack []int) (int, bool) {
+	lo, hi := 0, 0
+	for step := 1; hi < len(haystack) && haystack[hi] < needle; step *= 2 {
+		lo = hi + 1
+		hi += step
+	}
+	hi = min(hi, len(haystack))
+	i := SearchPredicate(lo, hi, func(i int) bool {
+		return haystack[i] >= needle
+	})
+	if i < len(haystack) && haystack[i] == needle {
+		return i, true
+	}
+	return 0, false
+}
+
+// ExponentialSearchFunc is a variant of ExponentialSearch for sequences that
+// are unbounded, or whose length is not known in advance, e.g. values that
+// are generated lazily. at(i) must return the i-th value of an ascending
+// sequence and true, or false if the sequence has fewer than i+1 values.
+// Values past the position of needle are never requested, apart from the
+// one probe that passes it.
+func ExponentialSearchFunc(needle int, at func(i int) (int, bool)) (int, bool) {
+	atLeast := func(i int) bool {
+		v, ok := at(i)
+		return !ok || v >= needle
+	}
+	lo, hi := 0, 0
+	for step := 1; !atLeast(hi); step *= 2 {
+		lo = hi + 1
+		hi += step
+	}
+	i := SearchPredicate(lo, hi, atLeast)
+	if v, ok := at(i); ok && v == needle {
+		return i, true
+	}
+	return 0, false
+}
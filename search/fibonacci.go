@@ -0,0 +1,40 @@
+package goalgorithms
+
+// FibonacciSearch performs a Fibonacci search for needle in haystack.
+// Like binary search it narrows down the range in which needle can be, but
+// splits the range at Fibonacci numbers instead of halving it, so positions
+// are computed with additions and subtractions only, and the probes of
+// successive steps are closer to each other.
+// Worst case time compexity: O(log(n))
+func FibonacciSearch(needle int, haystack []int) (int, bool) {
+	// fib is the smallest Fibonacci number not less than len(haystack),
+	// and fib1, fib2 are the two preceding ones.
+	fib2, fib1 := 0, 1
+	fib := fib1 + fib2
+	for fib < len(haystack) {
+		fib2, fib1 = fib1, fib
+		fib = fib1 + fib2
+	}
+
+	// All values up to offset are known to be smaller than needle.
+	offset := -1
+	for fib > 1 {
+		i := min(offset+fib2, len(haystack)-1)
+		if haystack[i] < needle {
+			// Continue in the upper fib1 positions.
+			fib, fib1 = fib1, fib2
+			fib2 = fib - fib1
+			offset = i
+		} else if haystack[i] > needle {
+			// Continue in the lower fib2 positions.
+			fib, fib1 = fib2, fib1-fib2
+			fib2 = fib - fib1
+		} else {
+			return i, true
+		}
+	}
+	if fib1 == 1 && offset+1 < len(haystack) && haystack[offset+1] == needle {
+		return offset + 1, true
+	}
+	return 0, false
+}
@@ -0,0 +1,71 @@
+package goalgorithms
+
+// interpolate estimates the position of needle in haystack[lo:hi+1] by linear
+// interpolation between haystack[lo] and haystack[hi], which must differ.
+// The arithmetic is done on float64, so that it cannot overflow.
+func interpolate(needle int, haystack []int, lo, hi int) int {
+	fraction := (float64(needle) - float64(haystack[lo])) / (float64(haystack[hi]) - float64(haystack[lo]))
+	m := lo + int(fraction*float64(hi-lo))
+	return max(lo, min(m, hi))
+}
+
+// InterpolationSearch performs an interpolation search for needle in
+// haystack. Instead of probing the middle of the range, it estimates where
+// needle should be from the values at both ends of the range, like one looks
+// up a name in a phone book.
+// As a safeguard against skewed data, a probe that fails to halve the range
+// is followed by a binary search step.
+// Average time compexity on uniformly distributed values: O(log(log(n)))
+// Worst case time compexity: O(log(n))
+func InterpolationSearch(needle int, haystack []int) (int, bool) {
+	lo, hi := 0, len(haystack)-1
+	bisect := false
+	for lo <= hi && needle >= haystack[lo] && needle <= haystack[hi] {
+		if haystack[lo] == haystack[hi] {
+			return lo, true
+		}
+		var m int
+		if bisect {
+			m = lo + (hi-lo)/2
+		} else {
+			m = interpolate(needle, haystack, lo, hi)
+		}
+
+		size := hi - lo
+		if haystack[m] < needle {
+			lo = m + 1
+		} else if haystack[m] > needle {
+			hi = m - 1
+		} else {
+			return m, true
+		}
+		bisect = hi-lo > size/2
+	}
+	return 0, false
+}
+
+// InterpolationSequentialSearch makes a single interpolation probe for needle
+// in haystack, and then scans sequentially from it towards needle.
+// Has less overhead than InterpolationSearch when values are uniformly
+// distributed, but degrades to a linear search when they are not.
+// Average time compexity on uniformly distributed values: O(sqrt(n))
+// Worst case time compexity: O(n)
+func InterpolationSequentialSearch(needle int, haystack []int) (int, bool) {
+	if len(haystack) == 0 || needle < haystack[0] || needle > haystack[len(haystack)-1] {
+		return 0, false
+	}
+	if haystack[0] == haystack[len(haystack)-1] {
+		return 0, true
+	}
+	i := interpolate(needle, haystack, 0, len(haystack)-1)
+	for haystack[i] < needle {
+		i++
+	}
+	for haystack[i] > needle {
+		i--
+	}
+	if haystack[i] == needle {
+		return i, true
+	}
+	return 0, false
+}
@@ -0,0 +1,45 @@
+package goalgorithms
+
+// StaircaseSearch searches for needle in matrix, whose rows are sorted in
+// ascending order from left to right and whose columns are sorted from top to
+// bottom. It returns the row and column of needle and true, or 0, 0 and false
+// if needle is not found.
+// The search starts at the top right corner and steps left when the value is
+// greater than needle and down when it is smaller, so every step drops a row
+// or a column. Takes O(rows+columns) time.
+func StaircaseSearch(needle int, matrix [][]int) (int, int, bool) {
+	row, col := 0, -1
+	if len(matrix) > 0 {
+		col = len(matrix[0]) - 1
+	}
+	for row < len(matrix) && col >= 0 {
+		if v := matrix[row][col]; v > needle {
+			col--
+		} else if v < needle {
+			row++
+		} else {
+			return row, col, true
+		}
+	}
+	return 0, 0, false
+}
+
+// SortedMatrixSearch performs a binary search for needle in matrix, whose
+// rows are sorted in ascending order and each of which starts with a value not
+// smaller than the last value of the row above, so that reading the rows one
+// after the other gives a sorted array. All rows must have the same length.
+// It returns the row and column of needle and true, or 0, 0 and false if
+// needle is not found. Takes O(log(rows*columns)) time.
+func SortedMatrixSearch(needle int, matrix [][]int) (int, int, bool) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return 0, 0, false
+	}
+	cols := len(matrix[0])
+	i := SearchPredicate(0, len(matrix)*cols, func(i int) bool {
+		return matrix[i/cols][i%cols] >= needle
+	})
+	if i < len(matrix)*cols && matrix[i/cols][i%cols] == needle {
+		return i / cols, i % cols, true
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,88 @@
+package goalgorithms
+
+import "math"
+
+// SearchPredicate performs a binary search on the range [lo, hi) for the
+// smallest i for which pred(i) is true, and returns hi if there is none.
+// pred must be monotone: false for a prefix of the range and true for the
+// rest of it, e.g. "capacity i is enough for the workload". pred is only
+// called with values in [lo, hi). Takes O(log(hi-lo)) calls to pred.
+// This is the loop all binary searches of this package are built on.
+func SearchPredicate(lo, hi int, pred func(int) bool) int {
+	for hi > lo {
+		// hi-lo may overflow int, but not uint.
+		middle := lo + int(uint(hi-lo)>>1)
+		if pred(middle) {
+			hi = middle
+		} else {
+			lo = middle + 1
+		}
+	}
+	return lo
+}
+
+// float64Key maps a float64 other than NaN to an int with the same order, so
+// that consecutive float64 values have consecutive keys. -0 comes just before
+// 0.
+func float64Key(f float64) int {
+	b := int64(math.Float64bits(f))
+	if b < 0 {
+		// Negative values are ordered by magnitude the wrong way round.
+		b ^= math.MaxInt64
+	}
+	return int(b)
+}
+
+// keyFloat64 is the inverse of float64Key.
+func keyFloat64(k int) float64 {
+	b := int64(k)
+	if b < 0 {
+		b ^= math.MaxInt64
+	}
+	return math.Float64frombits(uint64(b))
+}
+
+// SearchPredicateFloat64 performs a binary search on the range [lo, hi] for
+// the smallest x for which pred(x) is true, and returns hi if there is none.
+// pred must be false below some threshold and true above it.
+// The search runs SearchPredicate over the float64 values in the range,
+// ordered by their bit patterns, so each call to pred halves the number of
+// values left rather than the width of the range, and without limits it finds
+// the threshold exactly in at most 64 calls. A tolerance or maxIter that is
+// positive stops the search once the range is no wider than tolerance, or
+// after maxIter calls to pred.
+// The result is the upper end of the last range, so the threshold is within
+// its width below it. pred is never called with hi.
+func SearchPredicateFloat64(lo, hi, tolerance float64, maxIter int, pred func(float64) bool) float64 {
+	if !(hi > lo) {
+		return hi
+	}
+	// [left, right) is the range of keys that SearchPredicate has left.
+	left, right := float64Key(lo), float64Key(hi)
+	calls := 0
+	k := SearchPredicate(left, right, func(k int) bool {
+		// The width may overflow to +Inf, which is never within tolerance.
+		if maxIter > 0 && calls >= maxIter || tolerance > 0 && keyFloat64(right)-keyFloat64(left) <= tolerance {
+			// Answering false moves the search to right without more calls.
+			return false
+		}
+		calls++
+		if pred(keyFloat64(k)) {
+			right = k
+			return true
+		}
+		left = k + 1
+		return false
+	})
+	return keyFloat64(k)
+}
+
+// SearchPredicateSlice returns the index of the first element of haystack for
+// which pred is true, or len(haystack) if there is none. pred must be false for
+// a prefix of haystack and true for the rest, e.g. a comparison with a needle
+// on a sorted haystack. Takes O(log(n)) calls to pred.
+func SearchPredicateSlice[S ~[]E, E any](haystack S, pred func(E) bool) int {
+	return SearchPredicate(0, len(haystack), func(i int) bool {
+		return pred(haystack[i])
+	})
+}
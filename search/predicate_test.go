@@ -0,0 +1,124 @@
+package goalgorithms
+
+import (
+	"math"
+	"testing"
+)
+
+// shipWithin reports whether packages can be shipped in the given order within
+// days, without loading more than capacity on one day.
+func shipWithin(packages []int, days, capacity int) bool {
+	need, load := 1, 0
+	for _, p := range packages {
+		if p > capacity {
+			return false
+		}
+		if load+p > capacity {
+			need++
+			load = 0
+		}
+		load += p
+	}
+	return need <= days
+}
+
+func TestSearchPredicate(t *testing.T) {
+	packages := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tests := []struct {
+		name   string
+		lo, hi int
+		pred   func(int) bool
+		want   int
+	}{
+		{"Empty range", 5, 5, func(int) bool { return true }, 5},
+		{"Always true", -10, 10, func(int) bool { return true }, -10},
+		{"Never true", -10, 10, func(int) bool { return false }, 10},
+		{"Threshold", 0, 1000, func(i int) bool { return i*i >= 500 }, 23},
+		{"Negative threshold", -1000, 0, func(i int) bool { return i >= -77 }, -77},
+		{"Ship within 5 days", 1, 100, func(c int) bool { return shipWithin(packages, 5, c) }, 15},
+		{"Ship within 1 day", 1, 100, func(c int) bool { return shipWithin(packages, 1, c) }, 55},
+		{"Large range", math.MinInt, math.MaxInt, func(i int) bool { return i >= 1<<40 }, 1 << 40},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got := SearchPredicate(tt.lo, tt.hi, func(i int) bool {
+				if i < tt.lo || i >= tt.hi {
+					t.Fatalf("SearchPredicate(%v, %v) called pred(%v)", tt.lo, tt.hi, i)
+				}
+				calls++
+				return tt.pred(i)
+			})
+			if got != tt.want {
+				t.Fatalf("SearchPredicate(%v, %v) = %v, want %v", tt.lo, tt.hi, got, tt.want)
+			}
+			if calls > 64 {
+				t.Errorf("SearchPredicate(%v, %v) called pred %d times", tt.lo, tt.hi, calls)
+			}
+		})
+	}
+}
+
+func TestSearchPredicateFloat64(t *testing.T) {
+	sqrt2 := func(x float64) bool { return x*x >= 2 }
+	tests := []struct {
+		name      string
+		lo, hi    float64
+		tolerance float64
+		maxIter   int
+		pred      func(float64) bool
+		want      float64
+		// within is how far above want the result may be.
+		within    float64
+		wantCalls int
+	}{
+		{"Tolerance", 0, 2, 1e-9, 0, sqrt2, math.Sqrt2, 1e-9, 40},
+		{"Iteration limit", 0, 2, 0, 10, sqrt2, math.Sqrt2, 2, 10},
+		{"Both limits", 0, 2, 1e-12, 30, sqrt2, math.Sqrt2, 1e-5, 30},
+		{"No limit", 0, 2, 0, 0, sqrt2, math.Sqrt2, 0, 64},
+		{"Iteration limit of 64", 0, 2, 0, 64, sqrt2, math.Sqrt2, 0, 64},
+		{"Negative range", -3, -1, 0, 0, func(x float64) bool { return x >= -math.Sqrt2 }, -math.Sqrt2, 0, 64},
+		{"Whole range", -math.MaxFloat64, math.MaxFloat64, 0, 0, func(x float64) bool { return x >= 1e300 }, 1e300, 0, 64},
+		{"Whole range near zero", -math.MaxFloat64, math.MaxFloat64, 0, 0, func(x float64) bool { return x >= -1e-300 }, -1e-300, 0, 64},
+		{"Whole range, tolerance", -math.MaxFloat64, math.MaxFloat64, 1e-6, 0, func(x float64) bool { return x >= 0.5 }, 0.5, 1e-6, 32},
+		{"Never true", 0, 1, 1e-3, 0, func(float64) bool { return false }, 1, 0, 20},
+		{"Empty range", 1, 1, 1e-3, 0, sqrt2, 1, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got := SearchPredicateFloat64(tt.lo, tt.hi, tt.tolerance, tt.maxIter, func(x float64) bool {
+				calls++
+				return tt.pred(x)
+			})
+			if got < tt.want || got > tt.want+tt.within {
+				t.Errorf("SearchPredicateFloat64(%v, %v, %v, %v) = %v, want %v up to %v", tt.lo, tt.hi, tt.tolerance, tt.maxIter, got, tt.want, tt.want+tt.within)
+			}
+			if calls > tt.wantCalls {
+				t.Errorf("SearchPredicateFloat64(%v, %v, %v, %v) called pred %d times, want at most %d", tt.lo, tt.hi, tt.tolerance, tt.maxIter, calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestSearchPredicateSlice(t *testing.T) {
+	words := []string{"ant", "bee", "cat", "cow", "dog", "eel"}
+	tests := []struct {
+		prefix string
+		want   int
+	}{
+		{"a", 0},
+		{"c", 2},
+		{"co", 3},
+		{"d", 4},
+		{"f", 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			got := SearchPredicateSlice(words, func(w string) bool { return w >= tt.prefix })
+			if got != tt.want {
+				t.Fatalf("SearchPredicateSlice(%v, >= %q) = %v, want %v", words, tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
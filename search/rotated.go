@@ -0,0 +1,42 @@
+package goalgorithms
+
+// RotationOffset returns the number of positions by which the ascending array
+// haystack was rotated to the right, which is the index of its smallest value.
+// E.g. [4 5 1 2 3] is [1 2 3 4 5] rotated by 2.
+// Takes O(log(n)) time, but degrades to O(n) when many values repeat, as
+// equal values at both ends of the range don't tell which half the rotation
+// point is in.
+func RotationOffset(haystack []int) int {
+	lo, hi := 0, len(haystack)-1
+	for lo < hi {
+		middle := lo + (hi-lo)/2
+		if haystack[middle] > haystack[hi] {
+			lo = middle + 1
+		} else if haystack[middle] < haystack[hi] {
+			hi = middle
+		} else {
+			// Check if hi is the rotation point before dropping it.
+			if haystack[hi-1] > haystack[hi] {
+				return hi
+			}
+			hi--
+		}
+	}
+	return lo
+}
+
+// RotatedSearch performs a binary search for needle in haystack, which is an
+// ascending array rotated by some offset, like RotationOffset expects.
+// It finds the rotation offset and binary searches the part before or after it.
+// Takes O(log(n)) time, but see RotationOffset for repeated values.
+func RotatedSearch(needle int, haystack []int) (int, bool) {
+	if len(haystack) == 0 {
+		return 0, false
+	}
+	k := RotationOffset(haystack)
+	if needle >= haystack[k] && needle <= haystack[len(haystack)-1] {
+		i, ok := BinarySearchLinear(needle, haystack[k:])
+		return k + i, ok
+	}
+	return BinarySearchLinear(needle, haystack[:k])
+}
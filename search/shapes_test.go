@@ -0,0 +1,146 @@
+package goalgorithms
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestRotatedSearch(t *testing.T) {
+	tests := []struct {
+		name       string
+		haystack   []int
+		needle     int
+		wantOffset int
+		wantIdx    int
+		wantOK     bool
+	}{
+		{"Empty", nil, 1, 0, 0, false},
+		{"Single existing value", []int{2}, 2, 0, 0, true},
+		{"Not rotated", []int{1, 2, 3, 4, 5}, 4, 0, 3, true},
+		{"Rotated by one", []int{5, 1, 2, 3, 4}, 5, 1, 0, true},
+		{"Rotated by half", []int{4, 5, 6, 1, 2, 3}, 2, 3, 4, true},
+		{"Rotated to last", []int{2, 3, 4, 5, 1}, 1, 4, 4, true},
+		{"Missing before rotation", []int{40, 50, 60, 10, 20, 30}, 45, 3, 0, false},
+		{"Missing after rotation", []int{40, 50, 60, 10, 20, 30}, 25, 3, 0, false},
+		{"Smaller than all", []int{40, 50, 60, 10, 20, 30}, 5, 3, 0, false},
+		{"Repeating values", []int{2, 2, 2, 0, 2}, 0, 3, 3, true},
+		{"Repeating values at the peak", []int{1, 1, 2, 1}, 2, 3, 2, true},
+		{"All equal", []int{7, 7, 7, 7}, 7, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RotationOffset(tt.haystack); got != tt.wantOffset {
+				t.Errorf("RotationOffset(%v) = %v, want %v", tt.haystack, got, tt.wantOffset)
+			}
+			gotIdx, gotOK := RotatedSearch(tt.needle, tt.haystack)
+			if gotOK != tt.wantOK || gotOK && gotIdx != tt.wantIdx {
+				t.Errorf("RotatedSearch(%v, %v) = %v, %v, want %v, %v", tt.needle, tt.haystack, gotIdx, gotOK, tt.wantIdx, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRotatedSearch_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		sorted := make([]int, 1+rnd.Intn(50))
+		for j := range sorted {
+			sorted[j] = rnd.Intn(20)
+		}
+		slices.Sort(sorted)
+		k := rnd.Intn(len(sorted))
+		haystack := append(slices.Clone(sorted[len(sorted)-k:]), sorted[:len(sorted)-k]...)
+
+		offset := RotationOffset(haystack)
+		if rotated := append(slices.Clone(haystack[offset:]), haystack[:offset]...); !slices.Equal(rotated, sorted) {
+			t.Fatalf("RotationOffset(%v) = %v, which does not rotate it back to sorted", haystack, offset)
+		}
+		needle := rnd.Intn(22) - 1
+		idx, ok := RotatedSearch(needle, haystack)
+		if ok != slices.Contains(sorted, needle) || ok && haystack[idx] != needle {
+			t.Fatalf("RotatedSearch(%v, %v) = %v, %v", needle, haystack, idx, ok)
+		}
+	}
+}
+
+func TestBitonicSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		haystack []int
+		needle   int
+		wantPeak int
+		wantIdx  int
+		wantOK   bool
+	}{
+		{"Empty", nil, 1, 0, 0, false},
+		{"Single existing value", []int{2}, 2, 0, 0, true},
+		{"Peak in the middle", []int{1, 3, 8, 12, 4, 2}, 12, 3, 3, true},
+		{"Increasing side", []int{1, 3, 8, 12, 4, 2}, 3, 3, 1, true},
+		{"Decreasing side", []int{1, 3, 8, 12, 4, 2}, 4, 3, 4, true},
+		{"Equal on both sides", []int{1, 4, 9, 4, 2}, 4, 2, 1, true},
+		{"Missing", []int{1, 3, 8, 12, 4, 2}, 5, 3, 0, false},
+		{"Only increasing", []int{1, 2, 3, 4}, 1, 3, 0, true},
+		{"Only decreasing", []int{9, 7, 5, 3}, 3, 0, 3, true},
+		{"Greater than peak", []int{1, 5, 2}, 6, 1, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BitonicPeak(tt.haystack); got != tt.wantPeak {
+				t.Errorf("BitonicPeak(%v) = %v, want %v", tt.haystack, got, tt.wantPeak)
+			}
+			gotIdx, gotOK := BitonicSearch(tt.needle, tt.haystack)
+			if gotOK != tt.wantOK || gotOK && gotIdx != tt.wantIdx {
+				t.Errorf("BitonicSearch(%v, %v) = %v, %v, want %v, %v", tt.needle, tt.haystack, gotIdx, gotOK, tt.wantIdx, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMatrixSearch(t *testing.T) {
+	// Rows and columns are sorted, but rows overlap.
+	staircase := [][]int{
+		{1, 4, 7, 11, 15},
+		{2, 5, 8, 12, 19},
+		{3, 6, 9, 16, 22},
+		{10, 13, 14, 17, 24},
+	}
+	// Rows read one after the other are sorted.
+	sorted := [][]int{
+		{1, 3, 5, 7},
+		{10, 11, 16, 20},
+		{23, 30, 34, 60},
+	}
+	tests := []struct {
+		name    string
+		search  func(needle int, matrix [][]int) (int, int, bool)
+		matrix  [][]int
+		needle  int
+		wantRow int
+		wantCol int
+		wantOK  bool
+	}{
+		{"Staircase top left", StaircaseSearch, staircase, 1, 0, 0, true},
+		{"Staircase bottom right", StaircaseSearch, staircase, 24, 3, 4, true},
+		{"Staircase middle", StaircaseSearch, staircase, 9, 2, 2, true},
+		{"Staircase bottom left", StaircaseSearch, staircase, 10, 3, 0, true},
+		{"Staircase missing", StaircaseSearch, staircase, 20, 0, 0, false},
+		{"Staircase empty", StaircaseSearch, nil, 1, 0, 0, false},
+		{"Staircase empty rows", StaircaseSearch, [][]int{{}, {}}, 1, 0, 0, false},
+		{"Sorted first", SortedMatrixSearch, sorted, 1, 0, 0, true},
+		{"Sorted last", SortedMatrixSearch, sorted, 60, 2, 3, true},
+		{"Sorted row start", SortedMatrixSearch, sorted, 10, 1, 0, true},
+		{"Sorted missing", SortedMatrixSearch, sorted, 8, 0, 0, false},
+		{"Sorted greater than all", SortedMatrixSearch, sorted, 61, 0, 0, false},
+		{"Sorted empty", SortedMatrixSearch, nil, 1, 0, 0, false},
+		{"Staircase on sorted", StaircaseSearch, sorted, 34, 2, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, col, ok := tt.search(tt.needle, tt.matrix)
+			if row != tt.wantRow || col != tt.wantCol || ok != tt.wantOK {
+				t.Errorf("search(%v, %v) = %v, %v, %v, want %v, %v, %v", tt.needle, tt.matrix, row, col, ok, tt.wantRow, tt.wantCol, tt.wantOK)
+			}
+		})
+	}
+}
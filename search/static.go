@@ -0,0 +1,204 @@
+package goalgorithms
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Layout is the order in which a StaticIndex stores its keys.
+type Layout int
+
+const (
+	// EytzingerLayout stores the keys of a complete binary search tree in
+	// breadth first order, like a binary heap: the children of the key at
+	// position k are at 2k and 2k+1. The first levels of the tree share a few
+	// cache lines, and the 16 descendants four levels below a key are next to
+	// each other, so the memory a search touches is predictable and easy for
+	// the processor to prefetch.
+	EytzingerLayout Layout = iota
+	// BTreeLayout stores the keys as an implicit B+ tree with 16 keys per
+	// node (also known as an S+ tree). The leaves are the sorted keys
+	// themselves, and each node is two cache lines, so a search touches
+	// O(log17(n)) cache lines instead of O(log2(n)).
+	BTreeLayout
+)
+
+// bTreeKeys is the number of keys in a node of BTreeLayout.
+const bTreeKeys = 16
+
+// StaticIndex is a read-only index of sorted keys, which are stored in a
+// layout that makes searches on large arrays faster than binary search on the
+// sorted array. Search results are indices into the original sorted slice.
+type StaticIndex struct {
+	layout Layout
+	n      int
+	// eytzinger holds the keys in EytzingerLayout, starting at index 1.
+	eytzinger []int
+	// levels holds the nodes of each level of BTreeLayout, from the leaves to
+	// the root. Nodes that are not full are padded with math.MaxInt.
+	levels [][]int
+}
+
+// NewStaticIndex returns an index of the keys in sorted, which must be in
+// ascending order, stored in the given layout. The index keeps a copy of the
+// keys, so sorted can be modified or freed afterwards.
+// Takes O(n) time.
+func NewStaticIndex(sorted []int, layout Layout) *StaticIndex {
+	s := &StaticIndex{layout: layout, n: len(sorted)}
+	switch layout {
+	case EytzingerLayout:
+		s.eytzinger = make([]int, len(sorted)+1)
+		for k := 1; k <= len(sorted); k++ {
+			s.eytzinger[k] = sorted[s.eytzingerRank(k)]
+		}
+	case BTreeLayout:
+		s.buildBTree(sorted)
+	default:
+		panic("search: unknown static index layout")
+	}
+	return s
+}
+
+// Len returns the number of keys in the index.
+func (s *StaticIndex) Len() int {
+	return s.n
+}
+
+// Layout returns the layout of the index.
+func (s *StaticIndex) Layout() Layout {
+	return s.layout
+}
+
+// Search searches for needle in the index and returns the index of its first
+// occurrence in the sorted keys the index was made of, and true.
+// If needle is not found, it returns 0 and false like BinarySearchLinear.
+func (s *StaticIndex) Search(needle int) (int, bool) {
+	if s.layout == EytzingerLayout {
+		k := s.searchEytzinger(needle)
+		if k != 0 && s.eytzinger[k] == needle {
+			return s.eytzingerRank(k), true
+		}
+		return 0, false
+	}
+	i := s.lowerBoundBTree(needle)
+	if i < s.n && s.levels[0][i] == needle {
+		return i, true
+	}
+	return 0, false
+}
+
+// LowerBound returns the index in the sorted keys of the first key that is
+// not smaller than needle, or Len() if there is none, like LowerBound does on
+// the sorted keys.
+func (s *StaticIndex) LowerBound(needle int) int {
+	if s.layout == EytzingerLayout {
+		k := s.searchEytzinger(needle)
+		if k == 0 {
+			return s.n
+		}
+		return s.eytzingerRank(k)
+	}
+	return s.lowerBoundBTree(needle)
+}
+
+// eytzingerRank returns the index in the sorted keys of the key at position
+// k of EytzingerLayout, which is its position in an in-order walk of the tree.
+// In a perfect tree of h levels, the p-th node of level d is preceded by
+// (2p+1)*2^(h-1-d) - 1 nodes. The last level of the tree is only filled from
+// the left, so the leaves missing from it before the node are subtracted.
+func (s *StaticIndex) eytzingerRank(k int) int {
+	h := bits.Len(uint(s.n))
+	d := bits.Len(uint(k)) - 1
+	p := k - 1<<d
+	r := (2*p+1)<<(h-1-d) - 1
+	// Leaves of the perfect tree are at even ranks, and the first
+	// n - (2^(h-1) - 1) of them are present.
+	leaves := s.n - (1<<(h-1) - 1)
+	return r - max(0, (r+1)/2-leaves)
+}
+
+// searchEytzinger returns the position in EytzingerLayout of the first key
+// that is not smaller than needle, or 0 if there is none.
+// It descends the tree to a leaf, going right whenever the key is smaller
+// than needle. The comparison only selects the next position, so the compiler
+// can turn it into a conditional move instead of a branch, and the loop runs
+// the same number of times for every needle, give or take one.
+func (s *StaticIndex) searchEytzinger(needle int) int {
+	keys := s.eytzinger
+	k := 1
+	for k < len(keys) {
+		next := 2 * k
+		if keys[k] < needle {
+			next++
+		}
+		k = next
+	}
+	// The bits of k are the path from the root, 1 for right. The lower bound
+	// is the last node where the path went left, so strip the trailing right
+	// turns and the final left turn.
+	return k >> (bits.TrailingZeros(^uint(k)) + 1)
+}
+
+// buildBTree builds the levels of BTreeLayout. The leaves are the sorted keys,
+// split into nodes of 16 keys. A node on a higher level has 17 children, and
+// its i-th key is the smallest key under its child i+1.
+func (s *StaticIndex) buildBTree(sorted []int) {
+	nodes := (len(sorted) + bTreeKeys - 1) / bTreeKeys
+	leaves := make([]int, max(nodes, 1)*bTreeKeys)
+	copy(leaves, sorted)
+	for i := len(sorted); i < len(leaves); i++ {
+		leaves[i] = math.MaxInt
+	}
+	s.levels = [][]int{leaves}
+
+	// span is the number of leaf nodes under a node of the level being built.
+	for span := 1; nodes > 1; span *= bTreeKeys + 1 {
+		nodes = (nodes + bTreeKeys) / (bTreeKeys + 1)
+		level := make([]int, nodes*bTreeKeys)
+		for j := range level {
+			// Key i of node j is the smallest key under child j*17+i+1 of the
+			// level below, which is the first key of its leftmost leaf.
+			child := j/bTreeKeys*(bTreeKeys+1) + j%bTreeKeys + 1
+			leaf := child * span
+			if leaf*bTreeKeys < len(sorted) {
+				level[j] = leaves[leaf*bTreeKeys]
+			} else {
+				level[j] = math.MaxInt
+			}
+		}
+		s.levels = append(s.levels, level)
+	}
+}
+
+// lowerBoundBTree descends from the root to a leaf, counting in each node the
+// keys that are smaller than needle to choose the child to continue in.
+// The keys of a node are sorted, as the tree is built from sorted keys.
+func (s *StaticIndex) lowerBoundBTree(needle int) int {
+	j := 0
+	for h := len(s.levels) - 1; h >= 0; h-- {
+		node := (*[bTreeKeys]int)(s.levels[h][j*bTreeKeys:])
+		// Branchless binary search for the number of keys smaller than needle,
+		// which is one of 17 values, so it takes five comparisons.
+		i := 0
+		if node[i+7] < needle {
+			i += 8
+		}
+		if node[i+3] < needle {
+			i += 4
+		}
+		if node[i+1] < needle {
+			i += 2
+		}
+		if node[i] < needle {
+			i++
+		}
+		if node[i] < needle {
+			i++
+		}
+		if h == 0 {
+			return min(j*bTreeKeys+i, s.n)
+		}
+		j = j*(bTreeKeys+1) + i
+	}
+	return 0
+}
@@ -0,0 +1,115 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+var layouts = []struct {
+	name   string
+	layout Layout
+}{
+	{"Eytzinger", EytzingerLayout},
+	{"BTree", BTreeLayout},
+}
+
+func TestStaticIndex(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	sizes := []int{0, 1, 2, 3, 4, 5, 15, 16, 17, 31, 32, 33, 272, 289, 1000, 4913, 4914, 10000}
+	for _, n := range sizes {
+		sorted := make([]int, n)
+		for i := range sorted {
+			sorted[i] = rnd.Intn(2*n+1) - n/2
+		}
+		if n > 2 {
+			sorted[n-1] = math.MaxInt
+		}
+		slices.Sort(sorted)
+
+		for _, l := range layouts {
+			t.Run(fmt.Sprintf("%s_%d", l.name, n), func(t *testing.T) {
+				s := NewStaticIndex(sorted, l.layout)
+				if s.Len() != n || s.Layout() != l.layout {
+					t.Fatalf("NewStaticIndex() = index of %d keys in layout %v, want %d, %v", s.Len(), s.Layout(), n, l.layout)
+				}
+				needles := []int{math.MinInt, math.MaxInt}
+				for i := -n; i <= 2*n; i++ {
+					needles = append(needles, i)
+				}
+				for _, needle := range needles {
+					want := LowerBound(needle, sorted)
+					if got := s.LowerBound(needle); got != want {
+						t.Fatalf("LowerBound(%v) = %v, want %v", needle, got, want)
+					}
+					wantOK := want < n && sorted[want] == needle
+					if got, ok := s.Search(needle); ok != wantOK || ok && got != want {
+						t.Fatalf("Search(%v) = %v, %v, want %v, %v", needle, got, ok, want, wantOK)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestStaticIndex_EytzingerOrder(t *testing.T) {
+	for n := 1; n < 100; n++ {
+		sorted := make([]int, n)
+		for i := range sorted {
+			sorted[i] = i
+		}
+		s := NewStaticIndex(sorted, EytzingerLayout)
+
+		// An in-order walk of the tree must visit the keys in sorted order.
+		var walk func(k int)
+		next := 0
+		walk = func(k int) {
+			if k > n {
+				return
+			}
+			walk(2 * k)
+			if s.eytzinger[k] != next {
+				t.Fatalf("key at position %d of %d is %d, want %d", k, n, s.eytzinger[k], next)
+			}
+			next++
+			walk(2*k + 1)
+		}
+		walk(1)
+	}
+}
+
+// BenchmarkStaticIndex compares searches in the layouts of StaticIndex with
+// binary search on the sorted keys. With -short the largest size is skipped.
+func BenchmarkStaticIndex(b *testing.B) {
+	for _, n := range []int{1e3, 1e4, 1e5, 1e6, 1e7, 1e8} {
+		if n > 1e7 && testing.Short() {
+			continue
+		}
+		rnd := rand.New(rand.NewSource(1))
+		sorted := make([]int, n)
+		for i := range sorted {
+			sorted[i] = rnd.Int()
+		}
+		slices.Sort(sorted)
+		needles := make([]int, 4096)
+		for i := range needles {
+			needles[i] = rnd.Int()
+		}
+
+		b.Run(fmt.Sprintf("BinarySearchLinear_%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				BinarySearchLinear(needles[i%len(needles)], sorted)
+			}
+		})
+		for _, l := range layouts {
+			s := NewStaticIndex(sorted, l.layout)
+			b.Run(fmt.Sprintf("%s_%d", l.name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					s.Search(needles[i%len(needles)])
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,476 @@
+package goalgorithms
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// MatchKind selects which occurrences of the patterns an AhoCorasick
+// automaton reports.
+type MatchKind int
+
+const (
+	// Overlapping reports every occurrence of every pattern, including
+	// occurrences that overlap or contain each other.
+	Overlapping MatchKind = iota
+	// LeftmostLongest reports occurrences that do not overlap, scanning the
+	// text from left to right. Of the occurrences that start after the end of
+	// the previous one, the one that starts first is reported, and of those
+	// that start at the same offset, the longest one. This is how a regular
+	// expression that is the alternation of the patterns matches in POSIX.
+	LeftmostLongest
+)
+
+// scanBufferSize is the size of the chunks that Scan reads.
+const scanBufferSize = 32 << 10
+
+// maxDenseStates is the number of states of an AhoCorasick automaton, in
+// breadth first order, whose transitions are stored for every byte, taking
+// up to 1 KiB per state.
+const maxDenseStates = 512
+
+// AhoCorasick finds the occurrences of many patterns at once with the
+// Aho-Corasick algorithm. The patterns are stored in a trie, in which every
+// state also has a failure link to the state of the longest proper suffix of
+// its string that is also in the trie. The text is read one byte at a time,
+// following an edge of the trie when there is one and failure links when
+// there is not, so the state is always that of the longest suffix of the text
+// read so far that is a prefix of a pattern.
+// Building the automaton takes O(m) time, where m is the total length of the
+// patterns. Searching takes O(n+k) time, where k is the number of matches, in
+// Overlapping mode. In LeftmostLongest mode, the bytes read after a match
+// while looking for a longer one are read again, which takes O(n*l) time in
+// the worst case, where l is the length of the longest pattern.
+// An automaton is safe for concurrent use by multiple goroutines.
+type AhoCorasick struct {
+	kind        MatchKind
+	numPatterns int
+	// The states are numbered in breadth first order, so every state has a
+	// greater number than its parent and than the target of its failure link.
+	// The edges of state s are edgeBytes[edgeStart[s]:edgeStart[s+1]], sorted
+	// in ascending order, and lead to the states of edgeNext.
+	edgeStart []int32
+	edgeBytes []byte
+	edgeNext  []int32
+	// dense holds the transitions of the first states for every byte, at
+	// dense[s*256+b], which saves following failure links from the states
+	// that are visited the most.
+	dense []int32
+	depth []int32
+	fail  []int32
+	// dict is the closest state on the chain of failure links that is the end
+	// of a pattern, or 0 if there is none.
+	dict []int32
+	// The patterns that end at state s are outIDs[outStart[s]:outStart[s+1]].
+	outStart []int32
+	outIDs   []int32
+}
+
+// NewAhoCorasick returns an AhoCorasick automaton for patterns, that reports
+// matches of the given kind. Matches refer to patterns by their index in
+// patterns. Empty patterns are ignored.
+func NewAhoCorasick(patterns []string, kind MatchKind) *AhoCorasick {
+	// Build the trie with a map per state first, and then store it with the
+	// states renumbered in breadth first order.
+	type node struct {
+		next map[byte]int32
+		ids  []int32
+	}
+	nodes := []node{{}}
+	for id, p := range patterns {
+		if len(p) == 0 {
+			continue
+		}
+		s := int32(0)
+		for i := 0; i < len(p); i++ {
+			t, ok := nodes[s].next[p[i]]
+			if !ok {
+				t = int32(len(nodes))
+				nodes = append(nodes, node{})
+				if nodes[s].next == nil {
+					nodes[s].next = map[byte]int32{}
+				}
+				nodes[s].next[p[i]] = t
+			}
+			s = t
+		}
+		nodes[s].ids = append(nodes[s].ids, int32(id))
+	}
+
+	ac := &AhoCorasick{kind: kind, numPatterns: len(patterns)}
+	ac.edgeStart = make([]int32, 1, len(nodes)+1)
+	ac.outStart = make([]int32, 1, len(nodes)+1)
+	order := []int32{0}
+	for k := 0; k < len(order); k++ {
+		n := nodes[order[k]]
+		keys := make([]byte, 0, len(n.next))
+		for b := range n.next {
+			keys = append(keys, b)
+		}
+		slices.Sort(keys)
+		for _, b := range keys {
+			ac.edgeBytes = append(ac.edgeBytes, b)
+			ac.edgeNext = append(ac.edgeNext, int32(len(order)))
+			order = append(order, n.next[b])
+		}
+		ac.edgeStart = append(ac.edgeStart, int32(len(ac.edgeBytes)))
+		ac.outIDs = append(ac.outIDs, n.ids...)
+		ac.outStart = append(ac.outStart, int32(len(ac.outIDs)))
+	}
+	ac.index()
+
+	// The failure link of a child of state s on byte b is where the automaton
+	// goes on b from the failure link of s. Breadth first order ensures the
+	// links of all shallower states are known by then.
+	ac.fail = make([]int32, len(order))
+	for s := range order {
+		for e := ac.edgeStart[s]; e < ac.edgeStart[s+1]; e++ {
+			if s == 0 {
+				continue
+			}
+			f := ac.fail[s]
+			for {
+				if t, ok := ac.edge(f, ac.edgeBytes[e]); ok {
+					ac.fail[ac.edgeNext[e]] = t
+					break
+				}
+				if f == 0 {
+					break
+				}
+				f = ac.fail[f]
+			}
+		}
+	}
+	ac.link()
+	return ac
+}
+
+// index computes the depth of the states.
+func (ac *AhoCorasick) index() {
+	ac.depth = make([]int32, len(ac.edgeStart)-1)
+	for s := range ac.depth {
+		for e := ac.edgeStart[s]; e < ac.edgeStart[s+1]; e++ {
+			ac.depth[ac.edgeNext[e]] = ac.depth[s] + 1
+		}
+	}
+}
+
+// link computes the dictionary links and the dense transitions from the
+// failure links.
+func (ac *AhoCorasick) link() {
+	ac.dict = make([]int32, len(ac.fail))
+	for s := 1; s < len(ac.fail); s++ {
+		f := ac.fail[s]
+		if ac.outStart[f] < ac.outStart[f+1] {
+			ac.dict[s] = f
+		} else {
+			ac.dict[s] = ac.dict[f]
+		}
+	}
+
+	ac.dense = make([]int32, min(len(ac.fail), maxDenseStates)*256)
+	for s := 0; s*256 < len(ac.dense); s++ {
+		row := ac.dense[s*256 : (s+1)*256]
+		if s != 0 {
+			f := int(ac.fail[s])
+			copy(row, ac.dense[f*256:(f+1)*256])
+		}
+		for e := ac.edgeStart[s]; e < ac.edgeStart[s+1]; e++ {
+			row[ac.edgeBytes[e]] = ac.edgeNext[e]
+		}
+	}
+}
+
+// edge returns the state that the edge of state s on byte b leads to.
+func (ac *AhoCorasick) edge(s int32, b byte) (int32, bool) {
+	edges := ac.edgeBytes[ac.edgeStart[s]:ac.edgeStart[s+1]]
+	if e := bytes.IndexByte(edges, b); e >= 0 {
+		return ac.edgeNext[int(ac.edgeStart[s])+e], true
+	}
+	return 0, false
+}
+
+// next returns the state that the automaton goes to from state s on byte b.
+func (ac *AhoCorasick) next(s int32, b byte) int32 {
+	for int(s)*256 >= len(ac.dense) {
+		if t, ok := ac.edge(s, b); ok {
+			return t
+		}
+		s = ac.fail[s]
+	}
+	return ac.dense[int(s)*256+int(b)]
+}
+
+// longest returns the state of the longest pattern that is a suffix of the
+// string of state s, or 0 if there is none.
+func (ac *AhoCorasick) longest(s int32) int32 {
+	if ac.outStart[s] < ac.outStart[s+1] {
+		return s
+	}
+	return ac.dict[s]
+}
+
+// Scan reads text from r until io.EOF and calls yield for the matches of the
+// patterns, until yield returns false. The text is read in chunks, and
+// matches that span chunks are found too. Offsets of matches are from the
+// start of the text read from r.
+// In Overlapping mode, matches are reported in the order of their end, and
+// matches that end at the same offset from the longest to the shortest, and
+// by pattern index for equal patterns. In LeftmostLongest mode, the index of
+// the first of equal patterns is reported.
+// Scan returns the error that r returned, if it was not io.EOF.
+func (ac *AhoCorasick) Scan(r io.Reader, yield func(m Match) bool) error {
+	st := acStream{ac: ac}
+	buf := make([]byte, scanBufferSize)
+	for {
+		n, err := r.Read(buf)
+		if !st.write(buf[:n], yield) {
+			return nil
+		}
+		if err == io.EOF {
+			st.close(yield)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// FindAll returns the matches of the patterns in text, in the order that
+// Scan reports them.
+func (ac *AhoCorasick) FindAll(text string) []Match {
+	var matches []Match
+	ac.Scan(strings.NewReader(text), func(m Match) bool {
+		matches = append(matches, m)
+		return true
+	})
+	return matches
+}
+
+// Count returns the number of matches of the patterns in text.
+func (ac *AhoCorasick) Count(text string) int {
+	n := 0
+	ac.Scan(strings.NewReader(text), func(m Match) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// acStream is a search with an AhoCorasick automaton over a text that is
+// written to it in chunks.
+type acStream struct {
+	ac    *AhoCorasick
+	state int32
+	// pos is the offset of the next byte to read. In LeftmostLongest mode,
+	// pending holds the text from offset base on, which may have to be read
+	// again after a match.
+	pos     int
+	base    int
+	pending []byte
+	// cand is the leftmost-longest match found so far, if candLen is not
+	// zero. It is reported when no match that is yet to be found can be
+	// better.
+	cand    Match
+	candLen int
+}
+
+// write reads p and calls yield for the matches that can be reported. It
+// returns false if yield did.
+func (st *acStream) write(p []byte, yield func(m Match) bool) bool {
+	ac := st.ac
+	if ac.kind == Overlapping {
+		for _, b := range p {
+			st.state = ac.next(st.state, b)
+			for u := ac.longest(st.state); u != 0; u = ac.dict[u] {
+				start := st.pos - int(ac.depth[u]) + 1
+				for _, id := range ac.outIDs[ac.outStart[u]:ac.outStart[u+1]] {
+					if !yield(Match{Pattern: int(id), Offset: start}) {
+						return false
+					}
+				}
+			}
+			st.pos++
+		}
+		return true
+	}
+
+	st.pending = append(st.pending, p...)
+	for st.pos < st.base+len(st.pending) {
+		i := st.pos
+		st.state = ac.next(st.state, st.pending[i-st.base])
+		st.pos++
+		if u := ac.longest(st.state); u != 0 {
+			length := int(ac.depth[u])
+			start := i - length + 1
+			if st.candLen == 0 || start < st.cand.Offset || start == st.cand.Offset && length > st.candLen {
+				st.cand = Match{Pattern: int(ac.outIDs[ac.outStart[u]]), Offset: start}
+				st.candLen = length
+			}
+		}
+		// A match that is yet to be found starts with the string of the
+		// current state, or after it.
+		if st.candLen > 0 && i-int(ac.depth[st.state])+1 > st.cand.Offset {
+			if !st.commit(yield) {
+				return false
+			}
+		}
+	}
+	// Keep only the text after the candidate, which is read again if it is
+	// reported.
+	keep := st.pos
+	if st.candLen > 0 {
+		keep = st.cand.Offset + st.candLen
+	}
+	n := copy(st.pending, st.pending[keep-st.base:])
+	st.pending = st.pending[:n]
+	st.base = keep
+	return true
+}
+
+// commit reports the candidate match, and restarts the search from the root
+// right after it.
+func (st *acStream) commit(yield func(m Match) bool) bool {
+	m := st.cand
+	st.pos = m.Offset + st.candLen
+	st.state = 0
+	st.candLen = 0
+	return yield(m)
+}
+
+// close reports the matches that are left at the end of the text.
+func (st *acStream) close(yield func(m Match) bool) bool {
+	for st.candLen > 0 {
+		if !st.commit(yield) || !st.write(nil, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// acMagic starts the binary form of an AhoCorasick automaton.
+const acMagic = "AC\x01"
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the automaton
+// in a compact form, that UnmarshalBinary decodes without rebuilding it.
+func (ac *AhoCorasick) MarshalBinary() ([]byte, error) {
+	data := []byte(acMagic)
+	data = binary.AppendUvarint(data, uint64(ac.kind))
+	data = binary.AppendUvarint(data, uint64(ac.numPatterns))
+	data = binary.AppendUvarint(data, uint64(len(ac.fail)))
+	// The targets of the edges are not stored, as in breadth first order they
+	// are the next unused state numbers.
+	for s := range ac.fail {
+		data = binary.AppendUvarint(data, uint64(ac.edgeStart[s+1]-ac.edgeStart[s]))
+		data = append(data, ac.edgeBytes[ac.edgeStart[s]:ac.edgeStart[s+1]]...)
+		data = binary.AppendUvarint(data, uint64(ac.fail[s]))
+		data = binary.AppendUvarint(data, uint64(ac.outStart[s+1]-ac.outStart[s]))
+		for _, id := range ac.outIDs[ac.outStart[s]:ac.outStart[s+1]] {
+			data = binary.AppendUvarint(data, uint64(id))
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It decodes an
+// automaton encoded by MarshalBinary, and returns an error if data is not a
+// valid automaton.
+func (ac *AhoCorasick) UnmarshalBinary(data []byte) error {
+	if !bytes.HasPrefix(data, []byte(acMagic)) {
+		return fmt.Errorf("data is not an Aho-Corasick automaton")
+	}
+	data = data[len(acMagic):]
+	var err error
+	invalid := func(format string, args ...any) {
+		if err == nil {
+			err = fmt.Errorf("invalid Aho-Corasick automaton: "+format, args...)
+		}
+	}
+	// uvarint reads a number that must be smaller than limit.
+	uvarint := func(limit int) int {
+		if err != nil {
+			return 0
+		}
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			invalid("truncated data")
+			return 0
+		}
+		if v >= uint64(limit) {
+			invalid("value %d is out of range", v)
+			return 0
+		}
+		data = data[n:]
+		return int(v)
+	}
+
+	var a AhoCorasick
+	a.kind = MatchKind(uvarint(int(LeftmostLongest) + 1))
+	a.numPatterns = uvarint(1 << 31)
+	// Every state takes at least three bytes.
+	states := uvarint(len(data)/3 + 1)
+	if err == nil && states == 0 {
+		invalid("no states")
+	}
+	if err != nil {
+		return err
+	}
+	a.edgeStart = make([]int32, 1, states+1)
+	a.outStart = make([]int32, 1, states+1)
+	a.fail = make([]int32, states)
+	for s := 0; s < states && err == nil; s++ {
+		edges := uvarint(257)
+		if edges > len(data) {
+			invalid("truncated data")
+			break
+		}
+		for e, b := range data[:edges] {
+			if e > 0 && b <= data[e-1] {
+				invalid("edges of state %d are not sorted", s)
+			}
+			// The states must be in breadth first order, so the edges must
+			// lead to the next states, after the current one.
+			next := len(a.edgeNext) + 1
+			if next <= s || next >= states {
+				invalid("edge of state %d leads to state %d", s, next)
+			}
+			a.edgeBytes = append(a.edgeBytes, b)
+			a.edgeNext = append(a.edgeNext, int32(next))
+		}
+		data = data[edges:]
+		a.edgeStart = append(a.edgeStart, int32(len(a.edgeBytes)))
+		// Failure links of all states but the root lead to an earlier state.
+		a.fail[s] = int32(uvarint(max(s, 1)))
+		for n := uvarint(a.numPatterns + 1); n > 0 && err == nil; n-- {
+			a.outIDs = append(a.outIDs, int32(uvarint(a.numPatterns)))
+		}
+		a.outStart = append(a.outStart, int32(len(a.outIDs)))
+	}
+	if err == nil && len(a.edgeNext) != states-1 {
+		invalid("%d states, but %d edges", states, len(a.edgeNext))
+	}
+	if err == nil && a.outStart[1] > 0 {
+		invalid("root is the end of a pattern")
+	}
+	if err == nil && len(data) > 0 {
+		invalid("%d bytes of trailing data", len(data))
+	}
+	if err != nil {
+		return err
+	}
+	a.index()
+	// Following failure links must get closer to the root.
+	for s := 1; s < states; s++ {
+		if a.depth[a.fail[s]] >= a.depth[s] {
+			return fmt.Errorf("invalid Aho-Corasick automaton: failure link of state %d does not lead to a shallower state", s)
+		}
+	}
+	a.link()
+	*ac = a
+	return nil
+}
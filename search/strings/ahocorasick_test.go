@@ -0,0 +1,236 @@
+package goalgorithms
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// naiveLeftmostLongest returns the leftmost-longest matches of patterns in
+// text, preferring the first of equal patterns.
+func naiveLeftmostLongest(patterns []string, text string) []Match {
+	var matches []Match
+	for i := 0; i < len(text); {
+		best := -1
+		for id, p := range patterns {
+			if len(p) > 0 && strings.HasPrefix(text[i:], p) && (best < 0 || len(p) > len(patterns[best])) {
+				best = id
+			}
+		}
+		if best < 0 {
+			i++
+			continue
+		}
+		matches = append(matches, Match{Pattern: best, Offset: i})
+		i += len(patterns[best])
+	}
+	return matches
+}
+
+// sortMatches sorts matches by offset and pattern, like MultiRabinKarp does.
+func sortMatches(matches []Match) []Match {
+	slices.SortFunc(matches, func(a, b Match) int {
+		if a.Offset != b.Offset {
+			return a.Offset - b.Offset
+		}
+		return a.Pattern - b.Pattern
+	})
+	return matches
+}
+
+func TestAhoCorasick(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		text     string
+		kind     MatchKind
+		want     []Match
+	}{
+		{"No patterns", nil, "abc", Overlapping, nil},
+		{"Empty pattern", []string{""}, "abc", Overlapping, nil},
+		{"Empty text", []string{"a"}, "", Overlapping, nil},
+		{"Classic", []string{"he", "she", "his", "hers"}, "ushers", Overlapping, []Match{{1, 1}, {0, 2}, {3, 2}}},
+		{"Overlapping repeats", []string{"aa"}, "aaaa", Overlapping, []Match{{0, 0}, {0, 1}, {0, 2}}},
+		{"Nested", []string{"a", "ab", "abc"}, "abc", Overlapping, []Match{{0, 0}, {1, 0}, {2, 0}}},
+		{"Duplicates", []string{"ab", "ab"}, "ab", Overlapping, []Match{{0, 0}, {1, 0}}},
+		{"Leftmost-longest classic", []string{"he", "she", "his", "hers"}, "ushers", LeftmostLongest, []Match{{1, 1}}},
+		{"Leftmost-longest repeats", []string{"aa"}, "aaaaa", LeftmostLongest, []Match{{0, 0}, {0, 2}}},
+		{"Leftmost-longest nested", []string{"a", "ab", "abc"}, "abcab", LeftmostLongest, []Match{{2, 0}, {1, 3}}},
+		{"Leftmost beats longest", []string{"abcd", "bc", "c"}, "abcX", LeftmostLongest, []Match{{1, 1}}},
+		{"Rescan after match", []string{"ab", "c", "abcdX"}, "abcdY", LeftmostLongest, []Match{{0, 0}, {1, 2}}},
+		{"Leftmost-longest duplicates", []string{"ab", "ab"}, "abab", LeftmostLongest, []Match{{0, 0}, {0, 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ac := NewAhoCorasick(tt.patterns, tt.kind)
+			got := ac.FindAll(tt.text)
+			if tt.kind == Overlapping {
+				got = sortMatches(got)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("FindAll(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+			if got := ac.Count(tt.text); got != len(tt.want) {
+				t.Fatalf("Count(%q) = %v, want %v", tt.text, got, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestAhoCorasick_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	randomString := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "abc"[rnd.Intn(3)]
+		}
+		return string(b)
+	}
+	for i := 0; i < 1000; i++ {
+		patterns := make([]string, rnd.Intn(10))
+		for j := range patterns {
+			patterns[j] = randomString(rnd.Intn(6))
+		}
+		text := randomString(rnd.Intn(200))
+
+		want := NewMultiRabinKarp(patterns).FindAll(text)
+		got := sortMatches(NewAhoCorasick(patterns, Overlapping).FindAll(text))
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Overlapping %q FindAll(%q) = %v, want %v", patterns, text, got, want)
+		}
+
+		want = naiveLeftmostLongest(patterns, text)
+		got = NewAhoCorasick(patterns, LeftmostLongest).FindAll(text)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("LeftmostLongest %q FindAll(%q) = %v, want %v", patterns, text, got, want)
+		}
+	}
+}
+
+func TestAhoCorasick_Scan(t *testing.T) {
+	patterns := []string{"error", "warning", "timeout", "connection reset", "err"}
+	// Matches must be found across the chunks that Scan reads, and the
+	// reads of a single byte.
+	text := strings.Repeat("x", scanBufferSize-3) + "connection reset by peer, error, timeout, warning" + strings.Repeat("y", scanBufferSize)
+	for _, kind := range []MatchKind{Overlapping, LeftmostLongest} {
+		ac := NewAhoCorasick(patterns, kind)
+		want := ac.FindAll(text)
+		if len(want) == 0 || want[0] != (Match{Pattern: 3, Offset: scanBufferSize - 3}) {
+			t.Fatalf("kind %d: first match is not across the chunks: %v", kind, want)
+		}
+		var got []Match
+		err := ac.Scan(iotest.OneByteReader(strings.NewReader(text)), func(m Match) bool {
+			got = append(got, m)
+			return true
+		})
+		if err != nil {
+			t.Fatalf("kind %d: Scan returned error %v", kind, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("kind %d: Scan of single bytes = %v, want %v", kind, got, want)
+		}
+
+		got = got[:0]
+		ac.Scan(strings.NewReader(text), func(m Match) bool {
+			got = append(got, m)
+			return len(got) < 2
+		})
+		if !reflect.DeepEqual(got, want[:2]) {
+			t.Fatalf("kind %d: Scan stopped after %v, want %v", kind, got, want[:2])
+		}
+
+		wantErr := errors.New("read failed")
+		r := iotest.DataErrReader(iotest.ErrReader(wantErr))
+		if err := ac.Scan(r, func(m Match) bool { return true }); err != wantErr {
+			t.Fatalf("kind %d: Scan returned error %v, want %v", kind, err, wantErr)
+		}
+	}
+}
+
+func TestAhoCorasick_MarshalBinary(t *testing.T) {
+	patterns := []string{"he", "she", "his", "hers", "", "he", "\x00\xff"}
+	text := "ushers his shell \x00\xff hehe"
+	for _, kind := range []MatchKind{Overlapping, LeftmostLongest} {
+		ac := NewAhoCorasick(patterns, kind)
+		data, err := ac.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary returned error %v", err)
+		}
+		var got AhoCorasick
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary returned error %v", err)
+		}
+		if !reflect.DeepEqual(&got, ac) {
+			t.Fatalf("UnmarshalBinary(MarshalBinary()) = %+v, want %+v", got, ac)
+		}
+		if m, want := got.FindAll(text), ac.FindAll(text); !reflect.DeepEqual(m, want) {
+			t.Fatalf("decoded automaton FindAll(%q) = %v, want %v", text, m, want)
+		}
+
+		// Every truncated encoding is invalid.
+		for n := 0; n < len(data); n++ {
+			if err := got.UnmarshalBinary(data[:n]); err == nil {
+				t.Fatalf("UnmarshalBinary of %d of %d bytes returned no error", n, len(data))
+			}
+		}
+	}
+}
+
+// FuzzAhoCorasickUnmarshalBinary checks that decoding arbitrary data returns
+// either an error or an automaton that can search without failing.
+func FuzzAhoCorasickUnmarshalBinary(f *testing.F) {
+	for _, patterns := range [][]string{nil, {"a"}, {"he", "she", "his", "hers"}} {
+		data, _ := NewAhoCorasick(patterns, LeftmostLongest).MarshalBinary()
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ac AhoCorasick
+		if err := ac.UnmarshalBinary(data); err != nil {
+			return
+		}
+		for _, m := range ac.FindAll("ushers his shell") {
+			if m.Pattern < 0 || m.Pattern >= ac.numPatterns || m.Offset < 0 {
+				t.Fatalf("invalid match %v", m)
+			}
+		}
+	})
+}
+
+func BenchmarkAhoCorasick(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	randomWord := func() string {
+		w := make([]byte, 4+rnd.Intn(8))
+		for i := range w {
+			w[i] = byte('a' + rnd.Intn(26))
+		}
+		return string(w)
+	}
+	keywords := make([]string, 5000)
+	for i := range keywords {
+		keywords[i] = randomWord()
+	}
+	var sb strings.Builder
+	for sb.Len() < 1<<20 {
+		if rnd.Intn(100) == 0 {
+			sb.WriteString(keywords[rnd.Intn(len(keywords))])
+		} else {
+			sb.WriteString(randomWord())
+		}
+		sb.WriteByte(' ')
+	}
+	text := sb.String()
+	for _, kind := range []MatchKind{Overlapping, LeftmostLongest} {
+		ac := NewAhoCorasick(keywords, kind)
+		b.Run(fmt.Sprintf("kind=%d", kind), func(b *testing.B) {
+			b.SetBytes(int64(len(text)))
+			for i := 0; i < b.N; i++ {
+				ac.Scan(strings.NewReader(text), func(m Match) bool { return true })
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package goalgorithms
+
+import "slices"
+
+// Suggestion is a word found by an approximate search, and its distance from
+// the word that was searched for.
+type Suggestion struct {
+	Word     string
+	Distance int
+}
+
+// sortSuggestions orders suggestions by distance, and by word for equal
+// distances.
+func sortSuggestions(suggestions []Suggestion) {
+	slices.SortFunc(suggestions, func(a, b Suggestion) int {
+		if a.Distance != b.Distance {
+			return a.Distance - b.Distance
+		}
+		if a.Word < b.Word {
+			return -1
+		}
+		if a.Word > b.Word {
+			return 1
+		}
+		return 0
+	})
+}
+
+// BKTree is a Burkhard-Keller tree, which indexes words by their distance
+// in a metric space, like the edit distance. Every node holds a word, and its
+// children are keyed by their distance from it. As the distance satisfies the
+// triangle inequality, a search for the words within distance k of a word w
+// only has to visit the children of a node at distance d from w whose key is
+// between d-k and d+k.
+type BKTree struct {
+	root     *bkNode
+	size     int
+	distance func(a, b string) int
+}
+
+// bkNode is a node of a BKTree. children[d] is the child at distance d, or
+// nil if there is none.
+type bkNode struct {
+	word     string
+	children []*bkNode
+}
+
+// NewBKTree returns an empty BKTree that measures distances with distance,
+// which must be a metric, like Levenshtein or DamerauLevenshtein.
+// If distance is nil, Levenshtein is used.
+func NewBKTree(distance func(a, b string) int) *BKTree {
+	if distance == nil {
+		distance = Levenshtein
+	}
+	return &BKTree{distance: distance}
+}
+
+// Len returns the number of words in the tree.
+func (t *BKTree) Len() int {
+	return t.size
+}
+
+// Add adds word to the tree, and reports whether it was not in the tree
+// already. Takes O(h) distance computations, where h is the height of the
+// tree.
+func (t *BKTree) Add(word string) bool {
+	if t.root == nil {
+		t.root = &bkNode{word: word}
+		t.size++
+		return true
+	}
+	node := t.root
+	for {
+		d := t.distance(word, node.word)
+		if d == 0 {
+			return false
+		}
+		if d >= len(node.children) {
+			node.children = append(node.children, make([]*bkNode, d+1-len(node.children))...)
+		}
+		if node.children[d] == nil {
+			node.children[d] = &bkNode{word: word}
+			t.size++
+			return true
+		}
+		node = node.children[d]
+	}
+}
+
+// Search returns the words of the tree within distance k of word, ordered by
+// distance, and by word for equal distances.
+func (t *BKTree) Search(word string, k int) []Suggestion {
+	var found []Suggestion
+	if t.root == nil || k < 0 {
+		return found
+	}
+	stack := []*bkNode{t.root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		d := t.distance(word, node.word)
+		if d <= k {
+			found = append(found, Suggestion{Word: node.word, Distance: d})
+		}
+		for i := max(d-k, 0); i <= d+k && i < len(node.children); i++ {
+			if node.children[i] != nil {
+				stack = append(stack, node.children[i])
+			}
+		}
+	}
+	sortSuggestions(found)
+	return found
+}
@@ -0,0 +1,72 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// naiveSearch returns the words of dict within distance k of word, by
+// computing the distance to every one of them.
+func naiveSearch(dict []string, word string, k int, distance func(a, b string) int) []Suggestion {
+	var found []Suggestion
+	for _, w := range dict {
+		if d := distance(word, w); d <= k {
+			found = append(found, Suggestion{Word: w, Distance: d})
+		}
+	}
+	sortSuggestions(found)
+	return found
+}
+
+func TestBKTree(t *testing.T) {
+	words := []string{"book", "books", "cake", "boo", "boon", "cook", "cape", "cart", "book"}
+	tree := NewBKTree(nil)
+	added := 0
+	for _, w := range words {
+		if tree.Add(w) {
+			added++
+		}
+	}
+	if added != 8 || tree.Len() != 8 {
+		t.Fatalf("Add added %d words and Len() = %d, want 8", added, tree.Len())
+	}
+	tests := []struct {
+		word string
+		k    int
+		want []Suggestion
+	}{
+		{"bo", 1, []Suggestion{{"boo", 1}}},
+		{"book", 0, []Suggestion{{"book", 0}}},
+		{"book", 1, []Suggestion{{"book", 0}, {"boo", 1}, {"books", 1}, {"boon", 1}, {"cook", 1}}},
+		{"caqe", 1, []Suggestion{{"cake", 1}, {"cape", 1}}},
+		{"xyz", 2, nil},
+		{"book", -1, nil},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s_%d", tt.word, tt.k), func(t *testing.T) {
+			if got := tree.Search(tt.word, tt.k); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Search(%q, %d) = %v, want %v", tt.word, tt.k, got, tt.want)
+			}
+		})
+	}
+	if got := NewBKTree(nil).Search("book", 2); got != nil {
+		t.Fatalf("Search in empty tree = %v, want nil", got)
+	}
+}
+
+func TestBKTree_Dictionary(t *testing.T) {
+	dict := dictionary(5000)
+	for _, distance := range []func(a, b string) int{Levenshtein, DamerauLevenshtein} {
+		tree := NewBKTree(distance)
+		for _, w := range dict {
+			tree.Add(w)
+		}
+		for i, word := range []string{"getbuf", "gtebfu", "setkey12", "ctxerr", "x"} {
+			k := 1 + i%3
+			if got, want := tree.Search(word, k), naiveSearch(dict, word, k, distance); !reflect.DeepEqual(got, want) {
+				t.Fatalf("Search(%q, %d) = %v, want %v", word, k, got, want)
+			}
+		}
+	}
+}
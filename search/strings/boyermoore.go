@@ -0,0 +1,102 @@
+package goalgorithms
+
+// BoyerMoore is a Matcher that uses the Boyer-Moore algorithm.
+// It compares the pattern with the text from right to left, and after a
+// mismatch shifts the pattern by the larger of the shifts given by two rules:
+// the bad-character rule aligns the mismatched text byte with its last
+// occurrence in the pattern, and the good-suffix rule aligns the suffix that
+// did match with its next occurrence in the pattern.
+// Preprocessing takes O(m) time, searching takes O(n/m) time on typical texts
+// and O(n*m) in the worst case, when the pattern occurs many times.
+type BoyerMoore struct {
+	pattern string
+	// badChar[c] is the distance from the last occurrence of c in
+	// pattern[:m-1] to the end of the pattern, or m if there is none.
+	badChar [256]int
+	// goodSuffix[i] is the shift after a mismatch at pattern[i], when
+	// pattern[i+1:] did match.
+	goodSuffix []int
+}
+
+// NewBoyerMoore returns a BoyerMoore matcher for pattern.
+func NewBoyerMoore(pattern string) *BoyerMoore {
+	m := len(pattern)
+	bm := &BoyerMoore{pattern: pattern, goodSuffix: make([]int, m)}
+	for c := range bm.badChar {
+		bm.badChar[c] = m
+	}
+	for i := 0; i < m-1; i++ {
+		bm.badChar[pattern[i]] = m - 1 - i
+	}
+	if m == 0 {
+		return bm
+	}
+
+	// suffix[i] is the length of the longest common suffix of pattern and
+	// pattern[:i+1].
+	suffix := make([]int, m)
+	suffix[m-1] = m
+	g, f := m-1, 0
+	for i := m - 2; i >= 0; i-- {
+		if i > g && suffix[i+m-1-f] < i-g {
+			suffix[i] = suffix[i+m-1-f]
+			continue
+		}
+		g = min(g, i)
+		f = i
+		for g >= 0 && pattern[g] == pattern[g+m-1-f] {
+			g--
+		}
+		suffix[i] = f - g
+	}
+
+	gs := bm.goodSuffix
+	for i := range gs {
+		gs[i] = m
+	}
+	// The matched suffix does not occur again, but a suffix of it may be a
+	// prefix of the pattern.
+	j := 0
+	for i := m - 1; i >= 0; i-- {
+		if suffix[i] == i+1 {
+			for ; j < m-1-i; j++ {
+				if gs[j] == m {
+					gs[j] = m - 1 - i
+				}
+			}
+		}
+	}
+	// The matched suffix occurs again, preceded by a different byte.
+	for i := 0; i < m-1; i++ {
+		gs[m-1-suffix[i]] = m - 1 - i
+	}
+	return bm
+}
+
+func (bm *BoyerMoore) scan(text string, yield func(i int) bool) {
+	p := bm.pattern
+	m := len(p)
+	for j := 0; j <= len(text)-m; {
+		i := m - 1
+		for i >= 0 && p[i] == text[i+j] {
+			i--
+		}
+		if i < 0 {
+			if !yield(j) {
+				return
+			}
+			j += bm.goodSuffix[0]
+		} else {
+			j += max(bm.goodSuffix[i], bm.badChar[text[i+j]]-m+1+i)
+		}
+	}
+}
+
+// FindFirst returns the offset of the first occurrence of the pattern in text, or -1.
+func (bm *BoyerMoore) FindFirst(text string) int { return findFirst(bm, bm.pattern, text) }
+
+// FindAll returns the offsets of all occurrences of the pattern in text.
+func (bm *BoyerMoore) FindAll(text string) []int { return findAll(bm, bm.pattern, text) }
+
+// Count returns the number of occurrences of the pattern in text.
+func (bm *BoyerMoore) Count(text string) int { return count(bm, bm.pattern, text) }
@@ -0,0 +1,174 @@
+package goalgorithms
+
+// Levenshtein returns the edit distance between a and b, which is the
+// smallest number of single byte insertions, deletions and substitutions
+// that change a into b. It fills the dynamic programming table of Wagner and
+// Fischer one row at a time.
+// Worst case time compexity: O(n*m)
+// Worst case space compexity: O(m)
+func Levenshtein(a, b string) int {
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 0; i < len(a); i++ {
+		// diag is the distance between a[:i] and b[:j].
+		diag := row[0]
+		row[0] = i + 1
+		for j := 0; j < len(b); j++ {
+			cost := 1
+			if a[i] == b[j] {
+				cost = 0
+			}
+			d := min(row[j+1]+1, row[j]+1, diag+cost)
+			diag = row[j+1]
+			row[j+1] = d
+		}
+	}
+	return row[len(b)]
+}
+
+// DamerauLevenshtein returns the edit distance between a and b, in which
+// swapping two adjacent bytes also counts as one edit, like a typo does.
+// Unlike the simpler optimal string alignment distance, a substring may be
+// edited again after a swap, e.g. "ca" becomes "abc" in two edits.
+// This is the algorithm by Lowrance and Wagner, which remembers the last row
+// where every byte occurred in a.
+// Worst case time compexity: O(n*m)
+// Worst case space compexity: O(n*m)
+func DamerauLevenshtein(a, b string) int {
+	// d[i+1][j+1] is the distance between a[:i] and b[:j]. The extra first
+	// row and column hold a distance larger than any other, to disallow
+	// swaps with bytes that do not exist.
+	inf := len(a) + len(b)
+	w := len(b) + 2
+	d := make([]int, (len(a)+2)*w)
+	d[0] = inf
+	for i := 0; i <= len(a); i++ {
+		d[(i+1)*w] = inf
+		d[(i+1)*w+1] = i
+	}
+	for j := 0; j <= len(b); j++ {
+		d[j+1] = inf
+		d[w+j+1] = j
+	}
+	// lastRow[c] is the last row of a that holds byte c.
+	var lastRow [256]int
+	for i := 1; i <= len(a); i++ {
+		// lastCol is the last column of b in this row with the byte a[i-1].
+		lastCol := 0
+		for j := 1; j <= len(b); j++ {
+			k, l := lastRow[b[j-1]], lastCol
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+				lastCol = j
+			}
+			d[(i+1)*w+j+1] = min(
+				d[i*w+j]+cost,
+				d[(i+1)*w+j]+1,
+				d[i*w+j+1]+1,
+				// Swap a[k-1] with a[i-1], deleting the bytes between
+				// them, and inserting the bytes of b between l and j.
+				d[k*w+l]+(i-k-1)+1+(j-l-1),
+			)
+		}
+		lastRow[a[i-1]] = i
+	}
+	return d[(len(a)+1)*w+len(b)+1]
+}
+
+// LevenshteinMyers returns the same edit distance as Levenshtein, computed
+// with the bit-parallel algorithm by Myers, in the form given by Hyyrö.
+// A column of the dynamic programming table is stored as bit vectors of the
+// positive and negative differences between adjacent cells, so 64 cells are
+// updated at once with a few bitwise operations. Strings longer than 64 bytes
+// are split into blocks of 64 bytes, which pass carries to each other.
+// Worst case time compexity: O(n*ceil(m/64))
+// Worst case space compexity: O(m)
+func LevenshteinMyers(a, b string) int {
+	// Let a be the shorter string, which the bit vectors are made of.
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	m := len(a)
+	if m == 0 {
+		return len(b)
+	}
+	if m <= 64 {
+		return levenshteinMyers64(a, b)
+	}
+	blocks := (m + 63) / 64
+	// peq[k*256+c] has bit i set if a[k*64+i] is c.
+	peq := make([]uint64, blocks*256)
+	for i := 0; i < m; i++ {
+		peq[i/64*256+int(a[i])] |= 1 << (i % 64)
+	}
+	pv := make([]uint64, blocks)
+	mv := make([]uint64, blocks)
+	for k := range pv {
+		pv[k] = ^uint64(0)
+	}
+	last := uint64(1) << ((m - 1) % 64)
+	score := m
+	for j := 0; j < len(b); j++ {
+		// The first row of the table grows by one in every column.
+		hin := 1
+		for k := 0; k < blocks; k++ {
+			high := uint64(1) << 63
+			if k == blocks-1 {
+				high = last
+			}
+			hin = advanceBlock(&pv[k], &mv[k], peq[k*256+int(b[j])], hin, high)
+		}
+		score += hin
+	}
+	return score
+}
+
+// levenshteinMyers64 is LevenshteinMyers for a of up to 64 bytes, which fit in
+// a single block.
+func levenshteinMyers64(a, b string) int {
+	var peq [256]uint64
+	for i := 0; i < len(a); i++ {
+		peq[a[i]] |= 1 << i
+	}
+	pv, mv := ^uint64(0), uint64(0)
+	last := uint64(1) << (len(a) - 1)
+	score := len(a)
+	for j := 0; j < len(b); j++ {
+		score += advanceBlock(&pv, &mv, peq[b[j]], 1, last)
+	}
+	return score
+}
+
+// advanceBlock computes the next column of a block of 64 cells of the table
+// of LevenshteinMyers, from the vertical differences pv and mv of the previous
+// column, the matches eq of the current byte, and the horizontal difference
+// hin of the row above the block. It returns the horizontal difference of the
+// row at bit high, the last row of the block.
+func advanceBlock(pv, mv *uint64, eq uint64, hin int, high uint64) int {
+	xv := eq | *mv
+	if hin < 0 {
+		eq |= 1
+	}
+	xh := (((eq & *pv) + *pv) ^ *pv) | eq
+	ph := *mv | ^(xh | *pv)
+	mh := *pv & xh
+	hout := 0
+	if ph&high != 0 {
+		hout = 1
+	} else if mh&high != 0 {
+		hout = -1
+	}
+	ph <<= 1
+	mh <<= 1
+	if hin < 0 {
+		mh |= 1
+	} else if hin > 0 {
+		ph |= 1
+	}
+	*pv = mh | ^(xv | ph)
+	*mv = ph & xv
+	return hout
+}
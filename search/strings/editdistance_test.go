@@ -0,0 +1,153 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// naiveOSA returns the optimal string alignment distance, which allows swaps
+// of adjacent bytes that are not edited again. It is an upper bound of the
+// Damerau-Levenshtein distance.
+func naiveOSA(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b        string
+		levenshtein int
+		damerau     int
+	}{
+		{"", "", 0, 0},
+		{"", "abc", 3, 3},
+		{"abc", "", 3, 3},
+		{"abc", "abc", 0, 0},
+		{"kitten", "sitting", 3, 3},
+		{"flaw", "lawn", 2, 2},
+		{"ab", "ba", 2, 1},
+		{"ca", "abc", 3, 2},
+		{"identifier", "idnetifier", 2, 1},
+		{"receive", "recieve", 2, 1},
+		{"gumbo", "gambol", 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			if got := Levenshtein(tt.a, tt.b); got != tt.levenshtein {
+				t.Errorf("Levenshtein(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.levenshtein)
+			}
+			if got := LevenshteinMyers(tt.a, tt.b); got != tt.levenshtein {
+				t.Errorf("LevenshteinMyers(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.levenshtein)
+			}
+			if got := DamerauLevenshtein(tt.a, tt.b); got != tt.damerau {
+				t.Errorf("DamerauLevenshtein(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.damerau)
+			}
+		})
+	}
+}
+
+func TestEditDistance_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	randomString := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "abcd"[rnd.Intn(4)]
+		}
+		return string(b)
+	}
+	for i := 0; i < 3000; i++ {
+		// Lengths around 64 and 128 test the blocks of LevenshteinMyers.
+		maxLen := []int{8, 70, 140}[i%3]
+		a, b := randomString(rnd.Intn(maxLen)), randomString(rnd.Intn(maxLen))
+		want := Levenshtein(a, b)
+		if got := LevenshteinMyers(a, b); got != want {
+			t.Fatalf("LevenshteinMyers(%q, %q) = %v, want %v", a, b, got, want)
+		}
+		if got := Levenshtein(b, a); got != want {
+			t.Fatalf("Levenshtein(%q, %q) = %v, but %v the other way round", b, a, got, want)
+		}
+		d := DamerauLevenshtein(a, b)
+		if d > want || d > naiveOSA(a, b) {
+			t.Fatalf("DamerauLevenshtein(%q, %q) = %v, more than Levenshtein %v or OSA %v", a, b, d, want, naiveOSA(a, b))
+		}
+		if got := DamerauLevenshtein(b, a); got != d {
+			t.Fatalf("DamerauLevenshtein(%q, %q) = %v, but %v the other way round", b, a, got, d)
+		}
+	}
+}
+
+// FuzzLevenshteinMyers compares LevenshteinMyers with Levenshtein.
+func FuzzLevenshteinMyers(f *testing.F) {
+	f.Add("kitten", "sitting")
+	f.Add(strings.Repeat("ab", 40), strings.Repeat("ba", 33))
+	f.Fuzz(func(t *testing.T, a, b string) {
+		if got, want := LevenshteinMyers(a, b), Levenshtein(a, b); got != want {
+			t.Fatalf("LevenshteinMyers(%q, %q) = %v, want %v", a, b, got, want)
+		}
+	})
+}
+
+// dictionary returns n distinct identifiers made of random syllables, sorted
+// in ascending order.
+func dictionary(n int) []string {
+	rnd := rand.New(rand.NewSource(1))
+	syllables := strings.Fields("get set is has new add del max min ptr buf len cap idx key val err ctx req res cfg src dst tmp str num")
+	seen := map[string]bool{}
+	for len(seen) < n {
+		var sb strings.Builder
+		for k := 1 + rnd.Intn(4); k > 0; k-- {
+			sb.WriteString(syllables[rnd.Intn(len(syllables))])
+		}
+		if rnd.Intn(2) == 0 {
+			fmt.Fprint(&sb, rnd.Intn(100))
+		}
+		seen[sb.String()] = true
+	}
+	dict := make([]string, 0, n)
+	for w := range seen {
+		dict = append(dict, w)
+	}
+	slices.Sort(dict)
+	return dict
+}
+
+func BenchmarkEditDistance(b *testing.B) {
+	dict := dictionary(100000)
+	distances := []struct {
+		name     string
+		distance func(a, b string) int
+	}{
+		{"Levenshtein", Levenshtein},
+		{"DamerauLevenshtein", DamerauLevenshtein},
+		{"LevenshteinMyers", LevenshteinMyers},
+	}
+	for _, d := range distances {
+		b.Run(d.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				d.distance(dict[i%len(dict)], dict[(i*7919)%len(dict)])
+			}
+		})
+	}
+}
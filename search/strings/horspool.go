@@ -0,0 +1,47 @@
+package goalgorithms
+
+// Horspool is a Matcher that uses the Boyer-Moore-Horspool algorithm.
+// It compares the pattern with the text from right to left, and after each
+// attempt shifts the pattern by the distance from the last occurrence, in the
+// pattern, of the text byte under its last position to the end of the pattern.
+// Preprocessing takes O(m) time, searching takes O(n/m) time on typical texts
+// and O(n*m) in the worst case.
+type Horspool struct {
+	pattern string
+	shift   [256]int
+}
+
+// NewHorspool returns a Horspool matcher for pattern.
+func NewHorspool(pattern string) *Horspool {
+	m := &Horspool{pattern: pattern}
+	for c := range m.shift {
+		m.shift[c] = len(pattern)
+	}
+	for i := 0; i < len(pattern)-1; i++ {
+		m.shift[pattern[i]] = len(pattern) - 1 - i
+	}
+	return m
+}
+
+func (m *Horspool) scan(text string, yield func(i int) bool) {
+	p := m.pattern
+	last := len(p) - 1
+	for j := 0; j <= len(text)-len(p); j += m.shift[text[j+last]] {
+		i := last
+		for i >= 0 && p[i] == text[j+i] {
+			i--
+		}
+		if i < 0 && !yield(j) {
+			return
+		}
+	}
+}
+
+// FindFirst returns the offset of the first occurrence of the pattern in text, or -1.
+func (m *Horspool) FindFirst(text string) int { return findFirst(m, m.pattern, text) }
+
+// FindAll returns the offsets of all occurrences of the pattern in text.
+func (m *Horspool) FindAll(text string) []int { return findAll(m, m.pattern, text) }
+
+// Count returns the number of occurrences of the pattern in text.
+func (m *Horspool) Count(text string) int { return count(m, m.pattern, text) }
@@ -0,0 +1,57 @@
+package goalgorithms
+
+// KMP is a Matcher that uses the Knuth-Morris-Pratt algorithm.
+// It never moves backwards in the text: after a mismatch, the failure function
+// tells how much of the pattern still matches, as a prefix of the pattern
+// that is also a suffix of the part matched so far.
+// Preprocessing takes O(m) time and space, searching takes O(n) time.
+type KMP struct {
+	pattern string
+	// fail[j] is the length of the longest proper prefix of pattern[:j+1]
+	// that is also its suffix.
+	fail []int
+}
+
+// NewKMP returns a KMP matcher for pattern.
+func NewKMP(pattern string) *KMP {
+	fail := make([]int, len(pattern))
+	k := 0
+	for j := 1; j < len(pattern); j++ {
+		for k > 0 && pattern[j] != pattern[k] {
+			k = fail[k-1]
+		}
+		if pattern[j] == pattern[k] {
+			k++
+		}
+		fail[j] = k
+	}
+	return &KMP{pattern: pattern, fail: fail}
+}
+
+func (m *KMP) scan(text string, yield func(i int) bool) {
+	p := m.pattern
+	j := 0
+	for i := 0; i < len(text); i++ {
+		for j > 0 && text[i] != p[j] {
+			j = m.fail[j-1]
+		}
+		if text[i] == p[j] {
+			j++
+		}
+		if j == len(p) {
+			if !yield(i - j + 1) {
+				return
+			}
+			j = m.fail[j-1]
+		}
+	}
+}
+
+// FindFirst returns the offset of the first occurrence of the pattern in text, or -1.
+func (m *KMP) FindFirst(text string) int { return findFirst(m, m.pattern, text) }
+
+// FindAll returns the offsets of all occurrences of the pattern in text.
+func (m *KMP) FindAll(text string) []int { return findAll(m, m.pattern, text) }
+
+// Count returns the number of occurrences of the pattern in text.
+func (m *KMP) Count(text string) int { return count(m, m.pattern, text) }
@@ -0,0 +1,207 @@
+package goalgorithms
+
+import search "github.com/quasoft/goalgorithms/search"
+
+// LevenshteinAutomaton accepts the strings within a maximum edit distance of
+// a word. Its states are rows of the dynamic programming table of Levenshtein
+// for the word and the bytes read so far, with the distances capped at the
+// maximum plus one, so that the automaton is deterministic and every state
+// only depends on the bytes read.
+// A state is live if it can still lead to an accepted string, which is the
+// case while some cell of the row is within the maximum. This allows pruning
+// the search of a set of words, e.g. with Search on a sorted dictionary.
+type LevenshteinAutomaton struct {
+	word string
+	max  int
+	// bytes holds the distinct bytes of word in ascending order. All other
+	// bytes lead to the same states.
+	bytes []byte
+}
+
+// LevenshteinState is a state of a LevenshteinAutomaton. It must not be
+// modified.
+type LevenshteinState []int
+
+// NewLevenshteinAutomaton returns an automaton that accepts the strings
+// within Levenshtein distance max of word.
+func NewLevenshteinAutomaton(word string, max int) *LevenshteinAutomaton {
+	a := &LevenshteinAutomaton{word: word, max: max}
+	var seen [256]bool
+	for i := 0; i < len(word); i++ {
+		seen[word[i]] = true
+	}
+	for c := 0; c < 256; c++ {
+		if seen[c] {
+			a.bytes = append(a.bytes, byte(c))
+		}
+	}
+	return a
+}
+
+// Start returns the state of the automaton before reading any bytes.
+func (a *LevenshteinAutomaton) Start() LevenshteinState {
+	s := make(LevenshteinState, len(a.word)+1)
+	for i := range s {
+		s[i] = min(i, a.max+1)
+	}
+	return s
+}
+
+// Step returns the state that the automaton goes to from state s on byte c.
+// Takes O(m) time, where m is the length of the word.
+func (a *LevenshteinAutomaton) Step(s LevenshteinState, c byte) LevenshteinState {
+	next := make(LevenshteinState, len(s))
+	next[0] = min(s[0]+1, a.max+1)
+	for i := 1; i < len(s); i++ {
+		cost := 1
+		if a.word[i-1] == c {
+			cost = 0
+		}
+		next[i] = min(s[i]+1, next[i-1]+1, s[i-1]+cost, a.max+1)
+	}
+	return next
+}
+
+// IsMatch reports whether the bytes read up to state s are within the maximum
+// distance of the word.
+func (a *LevenshteinAutomaton) IsMatch(s LevenshteinState) bool {
+	return s[len(s)-1] <= a.max
+}
+
+// CanMatch reports whether some string that starts with the bytes read up to
+// state s is within the maximum distance of the word.
+func (a *LevenshteinAutomaton) CanMatch(s LevenshteinState) bool {
+	for _, d := range s {
+		if d <= a.max {
+			return true
+		}
+	}
+	return false
+}
+
+// Distance returns the distance between the word and the bytes read up to
+// state s, or the maximum distance plus one if it is greater than that.
+func (a *LevenshteinAutomaton) Distance(s LevenshteinState) int {
+	return s[len(s)-1]
+}
+
+// Match reports whether text is within the maximum distance of the word.
+func (a *LevenshteinAutomaton) Match(text string) bool {
+	s := a.Start()
+	for i := 0; i < len(text) && a.CanMatch(s); i++ {
+		s = a.Step(s, text[i])
+	}
+	return a.IsMatch(s)
+}
+
+// Search returns the words of dict within the maximum distance of the word,
+// ordered by distance, and by word for equal distances. dict must be sorted
+// in ascending order.
+// Instead of testing every word of dict, it alternates between finding the
+// smallest accepted string that is not smaller than the current word of dict,
+// and seeking to the first word of dict that is not smaller than that string
+// with LowerBound, as described by Nick Johnson. This skips the runs of words
+// of dict that cannot match without looking at them, so the time it takes
+// depends much more on the number of words near the word than on the size of
+// dict.
+func (a *LevenshteinAutomaton) Search(dict []string) []Suggestion {
+	var found []Suggestion
+	s, ok := a.nextValid("")
+	for i := 0; ok; {
+		i += search.LowerBoundOrdered(s, dict[i:])
+		if i == len(dict) {
+			break
+		}
+		w := dict[i]
+		if w == s {
+			found = append(found, Suggestion{Word: w, Distance: a.Distance(a.walk(w))})
+			// The smallest string that is greater than w.
+			w += "\x00"
+			i++
+		}
+		s, ok = a.nextValid(w)
+	}
+	sortSuggestions(found)
+	return found
+}
+
+// walk returns the state after reading text.
+func (a *LevenshteinAutomaton) walk(text string) LevenshteinState {
+	s := a.Start()
+	for i := 0; i < len(text); i++ {
+		s = a.Step(s, text[i])
+	}
+	return s
+}
+
+// nextValid returns the smallest string accepted by the automaton that is not
+// smaller than text, and false if there is none.
+func (a *LevenshteinAutomaton) nextValid(text string) (string, bool) {
+	// states[i] is the state after reading text[:i], for as long as it is
+	// live.
+	states := []LevenshteinState{a.Start()}
+	for i := 0; i < len(text) && a.CanMatch(states[i]); i++ {
+		states = append(states, a.Step(states[i], text[i]))
+	}
+	if n := len(states) - 1; !a.CanMatch(states[n]) {
+		states = states[:n]
+	} else if n == len(text) {
+		if a.IsMatch(states[n]) {
+			return text, true
+		}
+		// A live state can always be completed, by the rest of the word.
+		return a.complete(text, states[n]), true
+	}
+	// Replace the byte after the longest live prefix of text with a larger
+	// one that leads to a live state, trying shorter prefixes if there is
+	// none.
+	for i := len(states) - 1; i >= 0; i-- {
+		for _, c := range a.larger(int(text[i])) {
+			if s := a.Step(states[i], c); a.CanMatch(s) {
+				return a.complete(text[:i]+string(c), s), true
+			}
+		}
+	}
+	return "", false
+}
+
+// complete returns the smallest accepted string that starts with prefix, the
+// string read up to the live state s.
+func (a *LevenshteinAutomaton) complete(prefix string, s LevenshteinState) string {
+	b := []byte(prefix)
+	for !a.IsMatch(s) {
+		// Bytes that are not in the word only make the distance larger, so
+		// this cannot go on for more than the maximum distance without a
+		// byte of the word.
+		for _, c := range a.larger(-1) {
+			if next := a.Step(s, c); a.CanMatch(next) {
+				b = append(b, c)
+				s = next
+				break
+			}
+		}
+	}
+	return string(b)
+}
+
+// larger returns, in ascending order, the bytes of the word greater than c,
+// and the smallest byte greater than c that is not in the word, if there is
+// one. These are all the bytes greater than c that lead to different states.
+func (a *LevenshteinAutomaton) larger(c int) []byte {
+	var bytes []byte
+	other := c + 1
+	for _, b := range a.bytes {
+		if int(b) == other {
+			other++
+		}
+		if int(b) > c {
+			bytes = append(bytes, b)
+		}
+	}
+	if other < 256 {
+		// Keep the bytes sorted.
+		i := search.LowerBoundOrdered(byte(other), bytes)
+		bytes = append(bytes[:i], append([]byte{byte(other)}, bytes[i:]...)...)
+	}
+	return bytes
+}
@@ -0,0 +1,115 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestLevenshteinAutomaton_Match(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	randomString := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "abc"[rnd.Intn(3)]
+		}
+		return string(b)
+	}
+	for i := 0; i < 2000; i++ {
+		word, text, k := randomString(rnd.Intn(8)), randomString(rnd.Intn(8)), rnd.Intn(4)
+		a := NewLevenshteinAutomaton(word, k)
+		d := Levenshtein(word, text)
+		if got := a.Match(text); got != (d <= k) {
+			t.Fatalf("NewLevenshteinAutomaton(%q, %d).Match(%q) = %v, but distance is %d", word, k, text, got, d)
+		}
+		if got := a.Distance(a.walk(text)); got != min(d, k+1) {
+			t.Fatalf("NewLevenshteinAutomaton(%q, %d) distance to %q = %v, want %v", word, k, text, got, min(d, k+1))
+		}
+	}
+}
+
+func TestLevenshteinAutomaton_Search(t *testing.T) {
+	tests := []struct {
+		name string
+		dict []string
+		word string
+		k    int
+		want []Suggestion
+	}{
+		{"Empty dictionary", nil, "abc", 1, nil},
+		{"Empty word", []string{"", "a", "ab", "abc"}, "", 1, []Suggestion{{"", 0}, {"a", 1}}},
+		{"Negative distance", []string{"abc"}, "abc", -1, nil},
+		{"Exact", []string{"abc", "abd", "xyz"}, "abc", 0, []Suggestion{{"abc", 0}}},
+		{"Typos", []string{"\x00", "boo", "book", "books", "cook", "cool", "\xff"}, "book", 1,
+			[]Suggestion{{"book", 0}, {"boo", 1}, {"books", 1}, {"cook", 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewLevenshteinAutomaton(tt.word, tt.k).Search(tt.dict); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Search(%q) for %q within %d = %v, want %v", tt.dict, tt.word, tt.k, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLevenshteinAutomaton_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	randomString := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ab\x00\xff"[rnd.Intn(4)]
+		}
+		return string(b)
+	}
+	for i := 0; i < 300; i++ {
+		dict := make([]string, rnd.Intn(50))
+		for j := range dict {
+			dict[j] = randomString(rnd.Intn(6))
+		}
+		slices.Sort(dict)
+		dict = slices.Compact(dict)
+		word, k := randomString(rnd.Intn(5)), rnd.Intn(3)
+		want := naiveSearch(dict, word, k, Levenshtein)
+		if got := NewLevenshteinAutomaton(word, k).Search(dict); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Search(%q) for %q within %d = %v, want %v", dict, word, k, got, want)
+		}
+	}
+
+	dict := dictionary(20000)
+	for i, word := range []string{"getbuf", "gtebfu", "setkey12", "ctxerr", "x"} {
+		k := 1 + i%3
+		if got, want := NewLevenshteinAutomaton(word, k).Search(dict), naiveSearch(dict, word, k, Levenshtein); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Search for %q within %d = %v, want %v", word, k, got, want)
+		}
+	}
+}
+
+// BenchmarkApproximateSearch compares the ways to find the words of a
+// dictionary of 100k identifiers within a distance of a misspelled one.
+func BenchmarkApproximateSearch(b *testing.B) {
+	dict := dictionary(100000)
+	tree := NewBKTree(Levenshtein)
+	for _, w := range dict {
+		tree.Add(w)
+	}
+	queries := []string{"getbfu", "ctxreq", "setkeyval7", "mxaptr"}
+	for k := 1; k <= 2; k++ {
+		b.Run(fmt.Sprintf("LinearScan_k=%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				naiveSearch(dict, queries[i%len(queries)], k, LevenshteinMyers)
+			}
+		})
+		b.Run(fmt.Sprintf("BKTree_k=%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tree.Search(queries[i%len(queries)], k)
+			}
+		})
+		b.Run(fmt.Sprintf("LevenshteinAutomaton_k=%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				NewLevenshteinAutomaton(queries[i%len(queries)], k).Search(dict)
+			}
+		})
+	}
+}
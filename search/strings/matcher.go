@@ -0,0 +1,66 @@
+package goalgorithms
+
+// Matcher finds the occurrences of a pattern in texts.
+// Occurrences may overlap, e.g. "aa" occurs at 0, 1 and 2 in "aaaa".
+// An empty pattern occurs at every position of the text, from 0 to len(text).
+type Matcher interface {
+	// FindFirst returns the byte offset of the first occurrence of the
+	// pattern in text, or -1 if there is none, like strings.Index.
+	FindFirst(text string) int
+	// FindAll returns the byte offsets of all occurrences of the pattern in
+	// text, in ascending order.
+	FindAll(text string) []int
+	// Count returns the number of occurrences of the pattern in text.
+	Count(text string) int
+}
+
+// scanner is implemented by the matchers of this package. scan calls yield
+// with the offset of every occurrence of a non-empty pattern in text, in
+// ascending order, until yield returns false.
+type scanner interface {
+	scan(text string, yield func(i int) bool)
+}
+
+// scanAll calls yield for every occurrence found by s, or for every position
+// of text if the pattern is empty.
+func scanAll(s scanner, pattern, text string, yield func(i int) bool) {
+	if len(pattern) == 0 {
+		for i := 0; i <= len(text); i++ {
+			if !yield(i) {
+				return
+			}
+		}
+		return
+	}
+	if len(pattern) > len(text) {
+		return
+	}
+	s.scan(text, yield)
+}
+
+func findFirst(s scanner, pattern, text string) int {
+	first := -1
+	scanAll(s, pattern, text, func(i int) bool {
+		first = i
+		return false
+	})
+	return first
+}
+
+func findAll(s scanner, pattern, text string) []int {
+	var all []int
+	scanAll(s, pattern, text, func(i int) bool {
+		all = append(all, i)
+		return true
+	})
+	return all
+}
+
+func count(s scanner, pattern, text string) int {
+	n := 0
+	scanAll(s, pattern, text, func(i int) bool {
+		n++
+		return true
+	})
+	return n
+}
@@ -0,0 +1,156 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var matchers = []struct {
+	name string
+	new  func(pattern string) Matcher
+}{
+	{"KMP", func(p string) Matcher { return NewKMP(p) }},
+	{"BoyerMoore", func(p string) Matcher { return NewBoyerMoore(p) }},
+	{"Horspool", func(p string) Matcher { return NewHorspool(p) }},
+	{"RabinKarp", func(p string) Matcher { return NewRabinKarp(p) }},
+	{"TwoWay", func(p string) Matcher { return NewTwoWay(p) }},
+}
+
+// naiveFindAll returns the offsets of all, possibly overlapping, occurrences
+// of pattern in text.
+func naiveFindAll(pattern, text string) []int {
+	var all []int
+	for i := 0; i+len(pattern) <= len(text); i++ {
+		if text[i:i+len(pattern)] == pattern {
+			all = append(all, i)
+		}
+	}
+	return all
+}
+
+func TestMatcher(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		text    string
+		want    []int
+	}{
+		{"Empty pattern", "", "abc", []int{0, 1, 2, 3}},
+		{"Empty text", "a", "", nil},
+		{"Both empty", "", "", []int{0}},
+		{"Pattern longer than text", "abcd", "abc", nil},
+		{"Whole text", "abc", "abc", []int{0}},
+		{"Single byte", "a", "banana", []int{1, 3, 5}},
+		{"Overlapping", "ana", "banana", []int{1, 3}},
+		{"Repeated byte", "aa", "aaaa", []int{0, 1, 2}},
+		{"Periodic pattern", "abab", "abababab", []int{0, 2, 4}},
+		{"Not found", "abd", "abcabcabc", nil},
+		{"At the end", "xyz", "abcxyz", []int{3}},
+		{"Good suffix", "abcab", "abcababcabcab", []int{0, 5, 8}},
+		{"Binary", "\x00\xff", "\xff\x00\xff\x00\xff", []int{1, 3}},
+		{"Sentence", "fox", "the quick brown fox jumps over the lazy fox", []int{16, 40}},
+	}
+	for _, m := range matchers {
+		for _, tt := range tests {
+			t.Run(m.name+"/"+tt.name, func(t *testing.T) {
+				matcher := m.new(tt.pattern)
+				if got := matcher.FindAll(tt.text); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("%s(%q).FindAll(%q) = %v, want %v", m.name, tt.pattern, tt.text, got, tt.want)
+				}
+				wantFirst := strings.Index(tt.text, tt.pattern)
+				if got := matcher.FindFirst(tt.text); got != wantFirst {
+					t.Errorf("%s(%q).FindFirst(%q) = %v, want %v", m.name, tt.pattern, tt.text, got, wantFirst)
+				}
+				if got := matcher.Count(tt.text); got != len(tt.want) {
+					t.Errorf("%s(%q).Count(%q) = %v, want %v", m.name, tt.pattern, tt.text, got, len(tt.want))
+				}
+			})
+		}
+	}
+}
+
+func TestMatcher_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	randomString := func(n int, alphabet string) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = alphabet[rnd.Intn(len(alphabet))]
+		}
+		return string(b)
+	}
+	for i := 0; i < 2000; i++ {
+		alphabet := "ab"
+		if i%2 == 1 {
+			alphabet = "abcd"
+		}
+		text := randomString(rnd.Intn(100), alphabet)
+		pattern := randomString(1+rnd.Intn(6), alphabet)
+		want := naiveFindAll(pattern, text)
+		for _, m := range matchers {
+			if got := m.new(pattern).FindAll(text); !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s(%q).FindAll(%q) = %v, want %v", m.name, pattern, text, got, want)
+			}
+		}
+	}
+}
+
+// FuzzMatcher compares every matcher with strings.Index and with a naive
+// search for all occurrences.
+func FuzzMatcher(f *testing.F) {
+	f.Add("banana", "ana")
+	f.Add("aaaaaaaa", "aaa")
+	f.Add("abababcababab", "ababab")
+	f.Add("", "")
+	f.Add("abc", "")
+	f.Fuzz(func(t *testing.T, text, pattern string) {
+		wantFirst := strings.Index(text, pattern)
+		want := naiveFindAll(pattern, text)
+		if pattern == "" {
+			want = make([]int, len(text)+1)
+			for i := range want {
+				want[i] = i
+			}
+		}
+		for _, m := range matchers {
+			matcher := m.new(pattern)
+			if got := matcher.FindFirst(text); got != wantFirst {
+				t.Fatalf("%s(%q).FindFirst(%q) = %v, want %v", m.name, pattern, text, got, wantFirst)
+			}
+			if got := matcher.FindAll(text); !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s(%q).FindAll(%q) = %v, want %v", m.name, pattern, text, got, want)
+			}
+			if got := matcher.Count(text); got != len(want) {
+				t.Fatalf("%s(%q).Count(%q) = %v, want %v", m.name, pattern, text, got, len(want))
+			}
+		}
+	})
+}
+
+func BenchmarkMatcher(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	words := strings.Fields("lorem ipsum dolor sit amet consectetur adipiscing elit sed do eiusmod tempor incididunt ut labore et dolore magna aliqua")
+	var sb strings.Builder
+	for sb.Len() < 1<<20 {
+		sb.WriteString(words[rnd.Intn(len(words))])
+		sb.WriteByte(' ')
+	}
+	text := sb.String()
+	for _, pattern := range []string{"dolor", "consectetur adipiscing", "not in the text"} {
+		b.Run(fmt.Sprintf("strings.Index_%d", len(pattern)), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				strings.Count(text, pattern)
+			}
+		})
+		for _, m := range matchers {
+			matcher := m.new(pattern)
+			b.Run(fmt.Sprintf("%s_%d", m.name, len(pattern)), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					matcher.Count(text)
+				}
+			})
+		}
+	}
+}
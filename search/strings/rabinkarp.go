@@ -0,0 +1,144 @@
+package goalgorithms
+
+import "sort"
+
+// primeRK is the base of the rolling hash, the same prime as the standard
+// library uses. Hashes are computed modulo 2^64.
+const primeRK = 16777619
+
+// hashRK returns the hash of s and base^len(s), which is needed to remove the
+// first byte of a window from its hash.
+func hashRK(s string) (hash, pow uint64) {
+	pow = 1
+	for i := 0; i < len(s); i++ {
+		hash = hash*primeRK + uint64(s[i])
+		pow *= primeRK
+	}
+	return hash, pow
+}
+
+// RabinKarp is a Matcher that uses the Rabin-Karp algorithm.
+// It slides a window of the length of the pattern over the text, updating a
+// rolling hash of the window with every step, and only compares the window
+// with the pattern when their hashes are equal.
+// Preprocessing takes O(m) time, searching takes O(n+m) time on average and
+// O(n*m) in the worst case, when many hashes collide.
+type RabinKarp struct {
+	pattern string
+	hash    uint64
+	pow     uint64
+}
+
+// NewRabinKarp returns a RabinKarp matcher for pattern.
+func NewRabinKarp(pattern string) *RabinKarp {
+	hash, pow := hashRK(pattern)
+	return &RabinKarp{pattern: pattern, hash: hash, pow: pow}
+}
+
+func (m *RabinKarp) scan(text string, yield func(i int) bool) {
+	n := len(m.pattern)
+	h, _ := hashRK(text[:n])
+	for i := 0; ; i++ {
+		if h == m.hash && text[i:i+n] == m.pattern && !yield(i) {
+			return
+		}
+		if i+n == len(text) {
+			return
+		}
+		h = h*primeRK + uint64(text[i+n]) - m.pow*uint64(text[i])
+	}
+}
+
+// FindFirst returns the offset of the first occurrence of the pattern in text, or -1.
+func (m *RabinKarp) FindFirst(text string) int { return findFirst(m, m.pattern, text) }
+
+// FindAll returns the offsets of all occurrences of the pattern in text.
+func (m *RabinKarp) FindAll(text string) []int { return findAll(m, m.pattern, text) }
+
+// Count returns the number of occurrences of the pattern in text.
+func (m *RabinKarp) Count(text string) int { return count(m, m.pattern, text) }
+
+// Match is an occurrence of one of several patterns in a text.
+type Match struct {
+	// Pattern is the index of the pattern in the list of patterns that the
+	// matcher was made for.
+	Pattern int
+	// Offset is the byte offset of the occurrence in the text.
+	Offset int
+}
+
+// MultiRabinKarp finds the occurrences of several patterns at once with the
+// Rabin-Karp algorithm. Patterns are grouped by length, and the text is
+// scanned once for each distinct length, with a rolling hash of a window of
+// that length, which is looked up among the hashes of the patterns.
+// Searching takes O(n*k) time on average, where k is the number of distinct
+// pattern lengths.
+type MultiRabinKarp struct {
+	patterns []string
+	groups   []rkGroup
+}
+
+// rkGroup holds the patterns of one length.
+type rkGroup struct {
+	length int
+	pow    uint64
+	// ids holds the indices of the patterns by their hash.
+	ids map[uint64][]int
+}
+
+// NewMultiRabinKarp returns a MultiRabinKarp matcher for patterns.
+// Empty patterns are ignored.
+func NewMultiRabinKarp(patterns []string) *MultiRabinKarp {
+	m := &MultiRabinKarp{patterns: patterns}
+	byLength := map[int]int{}
+	for id, p := range patterns {
+		if len(p) == 0 {
+			continue
+		}
+		g, ok := byLength[len(p)]
+		if !ok {
+			g = len(m.groups)
+			byLength[len(p)] = g
+			_, pow := hashRK(p)
+			m.groups = append(m.groups, rkGroup{length: len(p), pow: pow, ids: map[uint64][]int{}})
+		}
+		hash, _ := hashRK(p)
+		m.groups[g].ids[hash] = append(m.groups[g].ids[hash], id)
+	}
+	return m
+}
+
+// FindAll returns all occurrences of the patterns in text, ordered by offset
+// and by pattern index for equal offsets.
+func (m *MultiRabinKarp) FindAll(text string) []Match {
+	var matches []Match
+	for _, g := range m.groups {
+		if g.length > len(text) {
+			continue
+		}
+		h, _ := hashRK(text[:g.length])
+		for i := 0; ; i++ {
+			for _, id := range g.ids[h] {
+				if text[i:i+g.length] == m.patterns[id] {
+					matches = append(matches, Match{Pattern: id, Offset: i})
+				}
+			}
+			if i+g.length == len(text) {
+				break
+			}
+			h = h*primeRK + uint64(text[i+g.length]) - g.pow*uint64(text[i])
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Offset != matches[j].Offset {
+			return matches[i].Offset < matches[j].Offset
+		}
+		return matches[i].Pattern < matches[j].Pattern
+	})
+	return matches
+}
+
+// Count returns the number of occurrences of the patterns in text.
+func (m *MultiRabinKarp) Count(text string) int {
+	return len(m.FindAll(text))
+}
@@ -0,0 +1,70 @@
+package goalgorithms
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// naiveFindAllMulti returns all occurrences of patterns in text, ordered by
+// offset and pattern.
+func naiveFindAllMulti(patterns []string, text string) []Match {
+	var matches []Match
+	for i := 0; i <= len(text); i++ {
+		for id, p := range patterns {
+			if len(p) > 0 && i+len(p) <= len(text) && text[i:i+len(p)] == p {
+				matches = append(matches, Match{Pattern: id, Offset: i})
+			}
+		}
+	}
+	return matches
+}
+
+func TestMultiRabinKarp(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		text     string
+		want     []Match
+	}{
+		{"No patterns", nil, "abc", nil},
+		{"Empty pattern", []string{"", "b"}, "abc", []Match{{1, 1}}},
+		{"Classic", []string{"he", "she", "his", "hers"}, "ushers", []Match{{1, 1}, {0, 2}, {3, 2}}},
+		{"Same length", []string{"ab", "bc", "ca"}, "abcab", []Match{{0, 0}, {1, 1}, {2, 2}, {0, 3}}},
+		{"Duplicate patterns", []string{"a", "a"}, "aa", []Match{{0, 0}, {1, 0}, {0, 1}, {1, 1}}},
+		{"Longer than text", []string{"abcdef"}, "abc", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMultiRabinKarp(tt.patterns)
+			if got := m.FindAll(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("MultiRabinKarp(%q).FindAll(%q) = %v, want %v", tt.patterns, tt.text, got, tt.want)
+			}
+			if got := m.Count(tt.text); got != len(tt.want) {
+				t.Fatalf("MultiRabinKarp(%q).Count(%q) = %v, want %v", tt.patterns, tt.text, got, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestMultiRabinKarp_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	randomString := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "abc"[rnd.Intn(3)]
+		}
+		return string(b)
+	}
+	for i := 0; i < 500; i++ {
+		patterns := make([]string, 1+rnd.Intn(8))
+		for j := range patterns {
+			patterns[j] = randomString(1 + rnd.Intn(5))
+		}
+		text := randomString(rnd.Intn(200))
+		want := naiveFindAllMulti(patterns, text)
+		if got := NewMultiRabinKarp(patterns).FindAll(text); !reflect.DeepEqual(got, want) {
+			t.Fatalf("MultiRabinKarp(%q).FindAll(%q) = %v, want %v", patterns, text, got, want)
+		}
+	}
+}
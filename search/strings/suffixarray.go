@@ -0,0 +1,295 @@
+package goalgorithms
+
+import (
+	"slices"
+	"strings"
+
+	search "github.com/quasoft/goalgorithms/search"
+)
+
+// SuffixArray is an index of a static text for fast substring queries. It
+// holds the suffix array of the text, which is the list of the offsets of all
+// suffixes of the text in lexicographic order, and their LCP array, which
+// holds the lengths of the longest common prefixes of adjacent suffixes.
+// Building it takes O(n) time and space.
+type SuffixArray struct {
+	text string
+	sa   []int
+	lcp  []int
+}
+
+// NewSuffixArray returns a SuffixArray of text.
+func NewSuffixArray(text string) *SuffixArray {
+	sa := BuildSuffixArray(text)
+	return &SuffixArray{text: text, sa: sa, lcp: LCPArray(text, sa)}
+}
+
+// Suffixes returns the suffix array of the text. It must not be modified.
+func (s *SuffixArray) Suffixes() []int {
+	return s.sa
+}
+
+// LCP returns the LCP array of the text, as returned by LCPArray. It must not
+// be modified.
+func (s *SuffixArray) LCP() []int {
+	return s.lcp
+}
+
+// lookup returns the range of the suffix array of the suffixes that start
+// with pattern. Takes O(m log(n)) time.
+func (s *SuffixArray) lookup(pattern string) (first, last int) {
+	return search.EqualRangeFunc(pattern, s.sa, func(i int, pattern string) int {
+		return strings.Compare(s.text[i:min(i+len(pattern), len(s.text))], pattern)
+	})
+}
+
+// Lookup returns the offsets of all occurrences of pattern in the text, in
+// ascending order. Like for a Matcher, the empty pattern occurs at every
+// offset from 0 to the length of the text.
+// Takes O(m log(n) + k log(k)) time, where k is the number of occurrences.
+func (s *SuffixArray) Lookup(pattern string) []int {
+	if len(pattern) == 0 {
+		all := make([]int, len(s.text)+1)
+		for i := range all {
+			all[i] = i
+		}
+		return all
+	}
+	first, last := s.lookup(pattern)
+	if first == last {
+		return nil
+	}
+	offsets := slices.Clone(s.sa[first:last])
+	slices.Sort(offsets)
+	return offsets
+}
+
+// Count returns the number of occurrences of pattern in the text.
+// Takes O(m log(n)) time.
+func (s *SuffixArray) Count(pattern string) int {
+	if len(pattern) == 0 {
+		return len(s.text) + 1
+	}
+	first, last := s.lookup(pattern)
+	return last - first
+}
+
+// LongestRepeatedSubstring returns the longest substring that occurs at least
+// twice in the text, possibly overlapping, or "" if there is none. Of several
+// such substrings, the lexicographically smallest one is returned. It is the
+// longest common prefix of two adjacent suffixes.
+// Takes O(n) time.
+func (s *SuffixArray) LongestRepeatedSubstring() string {
+	if len(s.lcp) == 0 {
+		return ""
+	}
+	best := 0
+	for i, l := range s.lcp {
+		if l > s.lcp[best] {
+			best = i
+		}
+	}
+	return s.text[s.sa[best] : s.sa[best]+s.lcp[best]]
+}
+
+// DistinctSubstrings returns the number of distinct non-empty substrings of
+// the text. Every substring is a prefix of a suffix, and the prefixes of a
+// suffix that are not prefixes of the previous suffix too are new.
+// Takes O(n) time.
+func (s *SuffixArray) DistinctSubstrings() int {
+	n := len(s.text)
+	count := n * (n + 1) / 2
+	for _, l := range s.lcp {
+		count -= l
+	}
+	return count
+}
+
+// BuildSuffixArray returns the suffix array of text, built with the SA-IS
+// algorithm by Nong, Zhang and Chan.
+// Worst case time compexity: O(n)
+// Worst case space compexity: O(n)
+func BuildSuffixArray(text string) []int {
+	s := make([]int, len(text))
+	for i := 0; i < len(text); i++ {
+		s[i] = int(text[i])
+	}
+	return sais(s, 255)
+}
+
+// sais returns the suffix array of s, whose values are in [0, upper].
+//
+// Suffixes are classified as S-type if they are smaller than the next suffix,
+// and as L-type if they are larger. An S-type suffix that follows an L-type
+// one is a leftmost S-type (LMS) suffix. Once the LMS suffixes are sorted,
+// induced sorting puts all other suffixes in order with two scans: L-type
+// suffixes are placed at the start of their bucket from left to right, and
+// S-type suffixes at the end of their bucket from right to left.
+// To sort the LMS suffixes, they are first induced sorted by their LMS
+// substrings only, which extend to the next LMS suffix. The substrings are
+// then named by their rank, and if two of them are equal, the suffix array of
+// the string of names, which is at most half as long, is built recursively.
+func sais(s []int, upper int) []int {
+	n := len(s)
+	switch n {
+	case 0:
+		return []int{}
+	case 1:
+		return []int{0}
+	case 2:
+		if s[0] < s[1] {
+			return []int{0, 1}
+		}
+		return []int{1, 0}
+	}
+
+	// isS[i] reports whether suffix i is S-type. The last suffix is L-type,
+	// as it is larger than the empty suffix.
+	isS := make([]bool, n)
+	for i := n - 2; i >= 0; i-- {
+		if s[i] == s[i+1] {
+			isS[i] = isS[i+1]
+		} else {
+			isS[i] = s[i] < s[i+1]
+		}
+	}
+	// The bucket of value c starts with its L-type suffixes at startL[c],
+	// followed by its S-type suffixes from startS[c] up to startL[c+1].
+	startL := make([]int, upper+2)
+	startS := make([]int, upper+2)
+	for i, c := range s {
+		if isS[i] {
+			startL[c+1]++
+		} else {
+			startS[c]++
+		}
+	}
+	for c := 0; c <= upper; c++ {
+		startS[c] += startL[c]
+		startL[c+1] += startS[c]
+	}
+
+	sa := make([]int, n)
+	buf := make([]int, upper+2)
+	induce := func(lms []int) {
+		for i := range sa {
+			sa[i] = -1
+		}
+		copy(buf, startS)
+		for _, i := range lms {
+			sa[buf[s[i]]] = i
+			buf[s[i]]++
+		}
+		copy(buf, startL)
+		sa[buf[s[n-1]]] = n - 1
+		buf[s[n-1]]++
+		for k := 0; k < n; k++ {
+			if i := sa[k] - 1; i >= 0 && !isS[i] {
+				sa[buf[s[i]]] = i
+				buf[s[i]]++
+			}
+		}
+		copy(buf, startL)
+		for k := n - 1; k >= 0; k-- {
+			if i := sa[k] - 1; i >= 0 && isS[i] {
+				buf[s[i]+1]--
+				sa[buf[s[i]+1]] = i
+			}
+		}
+	}
+
+	// lmsIndex[i] is the number of LMS suffixes before suffix i, if it is an
+	// LMS suffix, or -1 otherwise.
+	lmsIndex := make([]int, n+1)
+	var lms []int
+	for i := range lmsIndex {
+		lmsIndex[i] = -1
+	}
+	for i := 1; i < n; i++ {
+		if !isS[i-1] && isS[i] {
+			lmsIndex[i] = len(lms)
+			lms = append(lms, i)
+		}
+	}
+	induce(lms)
+	if len(lms) == 0 {
+		return sa
+	}
+
+	// Name the LMS substrings in sorted order, giving equal substrings the
+	// same name.
+	sorted := make([]int, 0, len(lms))
+	for _, i := range sa {
+		if lmsIndex[i] >= 0 {
+			sorted = append(sorted, i)
+		}
+	}
+	names := make([]int, len(lms))
+	name := 0
+	for k := 1; k < len(sorted); k++ {
+		l, r := sorted[k-1], sorted[k]
+		endL, endR := n, n
+		if lmsIndex[l]+1 < len(lms) {
+			endL = lms[lmsIndex[l]+1]
+		}
+		if lmsIndex[r]+1 < len(lms) {
+			endR = lms[lmsIndex[r]+1]
+		}
+		same := endL-l == endR-r
+		if same {
+			for l < endL && s[l] == s[r] {
+				l++
+				r++
+			}
+			same = r < n && l < n && s[l] == s[r]
+		}
+		if !same {
+			name++
+		}
+		names[lmsIndex[sorted[k]]] = name
+	}
+
+	// If all names differ, the LMS suffixes are already sorted. Otherwise sort
+	// them by the suffix array of their names. Then induce the order of all
+	// suffixes from them.
+	if name+1 < len(lms) {
+		for k, i := range sais(names, name) {
+			sorted[k] = lms[i]
+		}
+	}
+	induce(sorted)
+	return sa
+}
+
+// LCPArray returns the LCP array of text for its suffix array sa, built with
+// the algorithm by Kasai et al. Element i of the LCP array is the length of
+// the longest common prefix of the suffixes at sa[i-1] and sa[i], and element
+// 0 is 0. The algorithm visits the suffixes in the order of the text, as the
+// common prefix of suffix i+1 with its predecessor is at most one shorter
+// than that of suffix i.
+// Worst case time compexity: O(n)
+// Worst case space compexity: O(n)
+func LCPArray(text string, sa []int) []int {
+	n := len(sa)
+	rank := make([]int, n)
+	for k, i := range sa {
+		rank[i] = k
+	}
+	lcp := make([]int, n)
+	h := 0
+	for i := 0; i < n; i++ {
+		if rank[i] == 0 {
+			h = 0
+			continue
+		}
+		j := sa[rank[i]-1]
+		for i+h < n && j+h < n && text[i+h] == text[j+h] {
+			h++
+		}
+		lcp[rank[i]] = h
+		if h > 0 {
+			h--
+		}
+	}
+	return lcp
+}
@@ -0,0 +1,173 @@
+package goalgorithms
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// naiveSuffixArray sorts the suffixes of text by comparing them as strings.
+func naiveSuffixArray(text string) []int {
+	sa := make([]int, len(text))
+	for i := range sa {
+		sa[i] = i
+	}
+	sort.Slice(sa, func(i, j int) bool { return text[sa[i]:] < text[sa[j]:] })
+	return sa
+}
+
+// naiveLCPArray compares adjacent suffixes byte by byte.
+func naiveLCPArray(text string, sa []int) []int {
+	lcp := make([]int, len(sa))
+	for k := 1; k < len(sa); k++ {
+		for sa[k-1]+lcp[k] < len(text) && sa[k]+lcp[k] < len(text) && text[sa[k-1]+lcp[k]] == text[sa[k]+lcp[k]] {
+			lcp[k]++
+		}
+	}
+	return lcp
+}
+
+// naiveLongestRepeatedSubstring tries every pair of offsets.
+func naiveLongestRepeatedSubstring(text string) string {
+	best := ""
+	for i := 0; i < len(text); i++ {
+		for j := i + 1; j < len(text); j++ {
+			l := 0
+			for j+l < len(text) && text[i+l] == text[j+l] {
+				l++
+			}
+			if l > len(best) || l == len(best) && l > 0 && text[i:i+l] < best {
+				best = text[i : i+l]
+			}
+		}
+	}
+	return best
+}
+
+// naiveDistinctSubstrings collects all substrings of text in a set.
+func naiveDistinctSubstrings(text string) int {
+	set := map[string]bool{}
+	for i := 0; i < len(text); i++ {
+		for j := i + 1; j <= len(text); j++ {
+			set[text[i:j]] = true
+		}
+	}
+	return len(set)
+}
+
+func TestSuffixArray(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		sa       []int
+		lcp      []int
+		repeated string
+		distinct int
+	}{
+		{"Empty", "", []int{}, []int{}, "", 0},
+		{"Single byte", "a", []int{0}, []int{0}, "", 1},
+		{"Two bytes", "ba", []int{1, 0}, []int{0, 0}, "", 3},
+		{"Banana", "banana", []int{5, 3, 1, 0, 4, 2}, []int{0, 1, 3, 0, 0, 2}, "ana", 15},
+		{"Repeated byte", "aaaa", []int{3, 2, 1, 0}, []int{0, 1, 2, 3}, "aaa", 4},
+		{"Mississippi", "mississippi", []int{10, 7, 4, 1, 0, 9, 8, 6, 3, 5, 2}, []int{0, 1, 1, 4, 0, 0, 1, 0, 2, 1, 3}, "issi", 53},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSuffixArray(tt.text)
+			if got := s.Suffixes(); !reflect.DeepEqual(got, tt.sa) {
+				t.Errorf("Suffixes() = %v, want %v", got, tt.sa)
+			}
+			if got := s.LCP(); !reflect.DeepEqual(got, tt.lcp) {
+				t.Errorf("LCP() = %v, want %v", got, tt.lcp)
+			}
+			if got := s.LongestRepeatedSubstring(); got != tt.repeated {
+				t.Errorf("LongestRepeatedSubstring() = %q, want %q", got, tt.repeated)
+			}
+			if got := s.DistinctSubstrings(); got != tt.distinct {
+				t.Errorf("DistinctSubstrings() = %v, want %v", got, tt.distinct)
+			}
+		})
+	}
+}
+
+func TestSuffixArray_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		alphabet := "ab"
+		switch i % 3 {
+		case 1:
+			alphabet = "abcd"
+		case 2:
+			alphabet = "\x00\x01\xfe\xff"
+		}
+		b := make([]byte, rnd.Intn(100))
+		for j := range b {
+			b[j] = alphabet[rnd.Intn(len(alphabet))]
+		}
+		text := string(b)
+
+		s := NewSuffixArray(text)
+		want := naiveSuffixArray(text)
+		if got := s.Suffixes(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Suffixes() of %q = %v, want %v", text, got, want)
+		}
+		if got, want := s.LCP(), naiveLCPArray(text, want); !reflect.DeepEqual(got, want) {
+			t.Fatalf("LCP() of %q = %v, want %v", text, got, want)
+		}
+		if got, want := s.LongestRepeatedSubstring(), naiveLongestRepeatedSubstring(text); got != want {
+			t.Fatalf("LongestRepeatedSubstring() of %q = %q, want %q", text, got, want)
+		}
+		if got, want := s.DistinctSubstrings(), naiveDistinctSubstrings(text); got != want {
+			t.Fatalf("DistinctSubstrings() of %q = %v, want %v", text, got, want)
+		}
+		for k := 0; k < 5; k++ {
+			p := make([]byte, rnd.Intn(4))
+			for j := range p {
+				p[j] = alphabet[rnd.Intn(len(alphabet))]
+			}
+			pattern := string(p)
+			want := naiveFindAll(pattern, text)
+			if got := s.Lookup(pattern); !reflect.DeepEqual(got, want) {
+				t.Fatalf("Lookup(%q) in %q = %v, want %v", pattern, text, got, want)
+			}
+			if got := s.Count(pattern); got != len(want) {
+				t.Fatalf("Count(%q) in %q = %v, want %v", pattern, text, got, len(want))
+			}
+		}
+	}
+}
+
+func TestBuildSuffixArray_Large(t *testing.T) {
+	// Periodic texts have many equal LMS substrings, which makes the
+	// recursion deep.
+	texts := []string{
+		strings.Repeat("abcab", 2000),
+		strings.Repeat("a", 10000),
+		strings.Repeat("ab", 3000) + strings.Repeat("ba", 3000),
+	}
+	rnd := rand.New(rand.NewSource(1))
+	b := make([]byte, 10000)
+	for i := range b {
+		b[i] = byte(rnd.Intn(256))
+	}
+	texts = append(texts, string(b))
+	for _, text := range texts {
+		if got, want := BuildSuffixArray(text), naiveSuffixArray(text); !reflect.DeepEqual(got, want) {
+			t.Fatalf("BuildSuffixArray of %d bytes differs from naive construction", len(text))
+		}
+	}
+}
+
+func BenchmarkBuildSuffixArray(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	text := make([]byte, 1<<20)
+	for i := range text {
+		text[i] = "acgt"[rnd.Intn(4)]
+	}
+	b.SetBytes(int64(len(text)))
+	for i := 0; i < b.N; i++ {
+		BuildSuffixArray(string(text))
+	}
+}
@@ -0,0 +1,127 @@
+package goalgorithms
+
+// TwoWay is a Matcher that uses the Two-Way algorithm of Crochemore and Perrin,
+// which the C library uses for strstr.
+// The pattern is split at a critical factorization into a left and a right
+// part. The right part is compared from left to right, and only when it
+// matches, the left part is compared from right to left. The period of the
+// pattern gives the shift after a full match, and for periodic patterns the
+// matched prefix is remembered, so no byte of the text is compared twice.
+// Preprocessing takes O(m) time and O(1) space, searching takes O(n) time.
+type TwoWay struct {
+	pattern string
+	// ell is the last index of the left part of the critical factorization.
+	ell int
+	// period is the period of the pattern if periodic is true, or else a
+	// shift that is safe after a full match.
+	period   int
+	periodic bool
+}
+
+// maxSuffix returns the start, minus one, of the lexicographically largest
+// suffix of pattern, and its period. If reverse is true, the order of bytes is
+// reversed.
+func maxSuffix(pattern string, reverse bool) (ms, period int) {
+	ms, j, k, period := -1, 0, 1, 1
+	for j+k < len(pattern) {
+		a, b := pattern[j+k], pattern[ms+k]
+		if a == b {
+			if k != period {
+				k++
+			} else {
+				j += period
+				k = 1
+			}
+		} else if a < b != reverse {
+			j += k
+			k = 1
+			period = j - ms
+		} else {
+			ms = j
+			j = ms + 1
+			k, period = 1, 1
+		}
+	}
+	return ms, period
+}
+
+// NewTwoWay returns a TwoWay matcher for pattern.
+func NewTwoWay(pattern string) *TwoWay {
+	m := &TwoWay{pattern: pattern}
+	if len(pattern) == 0 {
+		return m
+	}
+	i, p := maxSuffix(pattern, false)
+	j, q := maxSuffix(pattern, true)
+	if i > j {
+		m.ell, m.period = i, p
+	} else {
+		m.ell, m.period = j, q
+	}
+	// The pattern is periodic if its left part occurs one period later.
+	if pattern[:m.ell+1] == pattern[m.period:m.period+m.ell+1] {
+		m.periodic = true
+	} else {
+		m.period = max(m.ell+1, len(pattern)-m.ell-1) + 1
+	}
+	return m
+}
+
+func (m *TwoWay) scan(text string, yield func(i int) bool) {
+	x, ell, per := m.pattern, m.ell, m.period
+	n := len(x)
+	if m.periodic {
+		// memory is the length, minus one, of the prefix known to match
+		// after a shift by the period.
+		memory := -1
+		for j := 0; j <= len(text)-n; {
+			i := max(ell, memory) + 1
+			for i < n && x[i] == text[i+j] {
+				i++
+			}
+			if i < n {
+				j += i - ell
+				memory = -1
+				continue
+			}
+			i = ell
+			for i > memory && x[i] == text[i+j] {
+				i--
+			}
+			if i <= memory && !yield(j) {
+				return
+			}
+			j += per
+			memory = n - per - 1
+		}
+		return
+	}
+
+	for j := 0; j <= len(text)-n; {
+		i := ell + 1
+		for i < n && x[i] == text[i+j] {
+			i++
+		}
+		if i < n {
+			j += i - ell
+			continue
+		}
+		i = ell
+		for i >= 0 && x[i] == text[i+j] {
+			i--
+		}
+		if i < 0 && !yield(j) {
+			return
+		}
+		j += per
+	}
+}
+
+// FindFirst returns the offset of the first occurrence of the pattern in text, or -1.
+func (m *TwoWay) FindFirst(text string) int { return findFirst(m, m.pattern, text) }
+
+// FindAll returns the offsets of all occurrences of the pattern in text.
+func (m *TwoWay) FindAll(text string) []int { return findAll(m, m.pattern, text) }
+
+// Count returns the number of occurrences of the pattern in text.
+func (m *TwoWay) Count(text string) int { return count(m, m.pattern, text) }
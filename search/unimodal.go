@@ -0,0 +1,187 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+)
+
+// TernarySearchMin returns the x in [lo, hi] at which f has its minimum.
+// f must be unimodal on the range: strictly decreasing up to the minimum and
+// strictly increasing after it. Each step compares f at two points that split
+// the range into thirds, and drops the third that cannot hold the minimum.
+// Takes O(log(hi-lo)) evaluations of f.
+func TernarySearchMin[T cmp.Ordered](lo, hi int, f func(int) T) int {
+	return ternarySearch(lo, hi, f, func(a, b T) bool {
+		return a < b
+	})
+}
+
+// TernarySearchMax returns the x in [lo, hi] at which f has its maximum, like
+// TernarySearchMin. f must be strictly increasing up to the maximum and
+// strictly decreasing after it.
+func TernarySearchMax[T cmp.Ordered](lo, hi int, f func(int) T) int {
+	return ternarySearch(lo, hi, f, func(a, b T) bool {
+		return a > b
+	})
+}
+
+// ternarySearch returns the x in [lo, hi] at which f has the best value,
+// where better(a, b) reports whether value a is better than value b.
+// f is evaluated twice per step and once per candidate of the final scan.
+func ternarySearch[T any](lo, hi int, f func(int) T, better func(a, b T) bool) int {
+	// lo <= hi, so hi-lo does not overflow uint.
+	for uint(hi-lo) > 2 {
+		third := int(uint(hi-lo) / 3)
+		m1, m2 := lo+third, hi-third
+		f1, f2 := f(m1), f(m2)
+		if better(f1, f2) {
+			hi = m2 - 1
+		} else if better(f2, f1) {
+			lo = m1 + 1
+		} else {
+			// Equal values on both sides of a strict extremum.
+			lo, hi = m1+1, m2-1
+		}
+	}
+	best, fbest := lo, f(lo)
+	// x stops at hi, so that it does not overflow if hi is math.MaxInt.
+	for x := lo; x < hi; {
+		x++
+		if fx := f(x); better(fx, fbest) {
+			best, fbest = x, fx
+		}
+	}
+	return best
+}
+
+// invPhi is 1/φ, where φ is the golden ratio.
+var invPhi = (math.Sqrt(5) - 1) / 2
+
+// GoldenSectionMin returns an x in [lo, hi] within tolerance of the point at
+// which f has its minimum. f must be unimodal on the range.
+// Like ternary search it compares f at two inner points, but they divide the
+// range in the golden ratio, so that one of them is an inner point of the next
+// step as well, and each step needs a single new evaluation of f.
+// Takes O(log((hi-lo)/tolerance)) evaluations of f. If tolerance is not
+// positive, the search stops when float64 precision runs out.
+func GoldenSectionMin(lo, hi, tolerance float64, f func(float64) float64) float64 {
+	return goldenSection(lo, hi, tolerance, f)
+}
+
+// GoldenSectionMax returns an x in [lo, hi] within tolerance of the point at
+// which f has its maximum, like GoldenSectionMin.
+func GoldenSectionMax(lo, hi, tolerance float64, f func(float64) float64) float64 {
+	return goldenSection(lo, hi, tolerance, func(x float64) float64 {
+		return -f(x)
+	})
+}
+
+func goldenSection(a, b, tolerance float64, f func(float64) float64) float64 {
+	c := b - invPhi*(b-a)
+	d := a + invPhi*(b-a)
+	fc, fd := f(c), f(d)
+	for b-a > tolerance && a < c && c < d && d < b {
+		if fc < fd {
+			b, d, fd = d, c, fc
+			c = b - invPhi*(b-a)
+			fc = f(c)
+		} else {
+			a, c, fc = c, d, fd
+			d = a + invPhi*(b-a)
+			fd = f(d)
+		}
+	}
+	return a + (b-a)/2
+}
+
+// brentMaxIter limits the number of iterations of BrentRoot.
+const brentMaxIter = 200
+
+// BrentRoot returns an x in [a, b] within tolerance of a root of f, using
+// Brent's method. f must be continuous and f(a) and f(b) must have opposite
+// signs, so that the range brackets a root. Each step tries inverse quadratic
+// interpolation or the secant method, and falls back to bisection whenever
+// they would converge slower than it, so it never takes much longer than
+// bisection but usually converges superlinearly.
+// Returns an error if the range does not bracket a root, or if no root is
+// found within 200 iterations.
+func BrentRoot(a, b, tolerance float64, f func(float64) float64) (float64, error) {
+	fa, fb := f(a), f(b)
+	if fa == 0 {
+		return a, nil
+	}
+	if fb == 0 {
+		return b, nil
+	}
+	if fa > 0 && fb > 0 || fa < 0 && fb < 0 {
+		return 0, fmt.Errorf("range [%v, %v] does not bracket a root, as f has the same sign at both ends", a, b)
+	}
+
+	// b is the best estimate of the root, c is the other end of the range
+	// that brackets the root, a is the previous estimate. d is the last step
+	// and e the step before it.
+	c, fc := b, fb
+	var d, e float64
+	for i := 0; i < brentMaxIter; i++ {
+		if fb > 0 && fc > 0 || fb < 0 && fc < 0 {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol := 2*epsilon*math.Abs(b) + tolerance/2
+		m := (c - b) / 2
+		if math.Abs(m) <= tol || fb == 0 {
+			return b, nil
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				// Secant method.
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				// Inverse quadratic interpolation.
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*m*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			// Accept the step only if it stays well within the range and
+			// is shorter than half the step before the last one.
+			if 2*p < min(3*m*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = m
+				e = d
+			}
+		} else {
+			d = m
+			e = d
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, m)
+		}
+		fb = f(b)
+	}
+	return b, fmt.Errorf("no root found in %d iterations", brentMaxIter)
+}
+
+// epsilon is the difference between 1 and the next float64.
+const epsilon = 0x1p-52
@@ -0,0 +1,175 @@
+package goalgorithms
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTernarySearch(t *testing.T) {
+	tests := []struct {
+		name    string
+		lo, hi  int
+		f       func(int) int
+		wantMin int
+	}{
+		{"Parabola", -1000, 1000, func(x int) int { return (x - 123) * (x - 123) }, 123},
+		{"Minimum at lo", 5, 500, func(x int) int { return x }, 5},
+		{"Minimum at hi", 5, 500, func(x int) int { return -x }, 500},
+		{"Single value", 7, 7, func(x int) int { return x }, 7},
+		{"Two values", 7, 8, func(x int) int { return -x }, 8},
+		{"Abs", -1 << 40, 1 << 40, func(x int) int { return max(x-99, 99-x) }, 99},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The range shrinks to at most 2/3 with two evaluations, and
+			// the final scan evaluates at most three values.
+			limit := 2*int(math.Log(float64(tt.hi-tt.lo+1))/math.Log(1.5)) + 3
+			evals := 0
+			got := TernarySearchMin(tt.lo, tt.hi, func(x int) int {
+				evals++
+				return tt.f(x)
+			})
+			if got != tt.wantMin {
+				t.Fatalf("TernarySearchMin(%v, %v) = %v, want %v", tt.lo, tt.hi, got, tt.wantMin)
+			}
+			if evals > limit {
+				t.Errorf("TernarySearchMin(%v, %v) used %d evaluations, want at most %d", tt.lo, tt.hi, evals, limit)
+			}
+
+			evals = 0
+			got = TernarySearchMax(tt.lo, tt.hi, func(x int) float64 {
+				evals++
+				return -float64(tt.f(x))
+			})
+			if got != tt.wantMin {
+				t.Fatalf("TernarySearchMax(%v, %v) = %v, want %v", tt.lo, tt.hi, got, tt.wantMin)
+			}
+			if evals > limit {
+				t.Errorf("TernarySearchMax(%v, %v) used %d evaluations, want at most %d", tt.lo, tt.hi, evals, limit)
+			}
+		})
+	}
+}
+
+func TestTernarySearch_ExtremeRange(t *testing.T) {
+	// dist returns the distance from p to x, which does not overflow uint.
+	dist := func(p int) func(x int) uint {
+		return func(x int) uint {
+			if x >= p {
+				return uint(x - p)
+			}
+			return uint(p - x)
+		}
+	}
+	tests := []struct {
+		name    string
+		lo, hi  int
+		wantMin int
+	}{
+		{"Whole int range", math.MinInt, math.MaxInt, 12345},
+		{"Minimum at MinInt", math.MinInt, math.MaxInt, math.MinInt},
+		{"Minimum at MaxInt", math.MinInt, math.MaxInt, math.MaxInt},
+		{"Last values", math.MaxInt - 2, math.MaxInt, math.MaxInt - 1},
+		{"Single value", math.MaxInt, math.MaxInt, math.MaxInt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evals := 0
+			f := dist(tt.wantMin)
+			got := TernarySearchMin(tt.lo, tt.hi, func(x int) uint {
+				evals++
+				return f(x)
+			})
+			if got != tt.wantMin {
+				t.Errorf("TernarySearchMin(%v, %v) = %v, want %v", tt.lo, tt.hi, got, tt.wantMin)
+			}
+			// 2^64 values shrink to at most three in 110 steps.
+			if evals > 2*110+3 {
+				t.Errorf("TernarySearchMin(%v, %v) used %d evaluations", tt.lo, tt.hi, evals)
+			}
+		})
+	}
+}
+
+func TestGoldenSection(t *testing.T) {
+	tests := []struct {
+		name      string
+		lo, hi    float64
+		tolerance float64
+		f         func(float64) float64
+		wantMin   float64
+	}{
+		{"Parabola", -10, 10, 1e-8, func(x float64) float64 { return (x - 1.5) * (x - 1.5) }, 1.5},
+		{"Cosine", 0, 2 * math.Pi, 1e-8, math.Cos, math.Pi},
+		{"Minimum at lo", 2, 3, 1e-8, func(x float64) float64 { return x }, 2},
+		// Near a minimum f is flat, so comparisons of f cannot tell points
+		// closer than about the square root of float64 precision apart.
+		{"Exponential", -5, 5, 1e-7, func(x float64) float64 { return math.Exp(x) - 2*x }, math.Ln2},
+		{"No tolerance", -10, 10, 0, func(x float64) float64 { return math.Abs(x - 0.25) }, 0.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evals := 0
+			got := GoldenSectionMin(tt.lo, tt.hi, tt.tolerance, func(x float64) float64 {
+				evals++
+				return tt.f(x)
+			})
+			if math.Abs(got-tt.wantMin) > max(tt.tolerance, 1e-12) {
+				t.Fatalf("GoldenSectionMin(%v, %v, %v) = %v, want %v", tt.lo, tt.hi, tt.tolerance, got, tt.wantMin)
+			}
+			t.Logf("GoldenSectionMin(%v, %v, %v) used %d evaluations", tt.lo, tt.hi, tt.tolerance, evals)
+			if tt.tolerance > 0 {
+				// The range shrinks by 1/φ with every evaluation.
+				limit := int(math.Log(tt.tolerance/(tt.hi-tt.lo))/math.Log(invPhi)) + 3
+				if evals > limit {
+					t.Errorf("GoldenSectionMin(%v, %v, %v) used %d evaluations, want at most %d", tt.lo, tt.hi, tt.tolerance, evals, limit)
+				}
+			}
+
+			got = GoldenSectionMax(tt.lo, tt.hi, tt.tolerance, func(x float64) float64 { return -tt.f(x) })
+			if math.Abs(got-tt.wantMin) > max(tt.tolerance, 1e-12) {
+				t.Fatalf("GoldenSectionMax(%v, %v, %v) = %v, want %v", tt.lo, tt.hi, tt.tolerance, got, tt.wantMin)
+			}
+		})
+	}
+}
+
+func TestBrentRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     float64
+		f        func(float64) float64
+		want     float64
+		maxEvals int
+	}{
+		{"Linear", -10, 10, func(x float64) float64 { return 2*x - 3 }, 1.5, 5},
+		{"Square root of two", 0, 2, func(x float64) float64 { return x*x - 2 }, math.Sqrt2, 12},
+		{"Cubic", -4, 4 / 3.0, func(x float64) float64 { return (x + 3) * (x - 1) * (x - 1) }, -3, 50},
+		{"Cosine", 0, 3, math.Cos, math.Pi / 2, 10},
+		{"Root at end", 0, 1, func(x float64) float64 { return x }, 0, 2},
+		{"Flat near root", -1, 2, func(x float64) float64 { return math.Pow(x-0.5, 5) }, 0.5, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evals := 0
+			got, err := BrentRoot(tt.a, tt.b, 1e-12, func(x float64) float64 {
+				evals++
+				return tt.f(x)
+			})
+			if err != nil {
+				t.Fatalf("BrentRoot(%v, %v) returned error: %v", tt.a, tt.b, err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Fatalf("BrentRoot(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			t.Logf("BrentRoot(%v, %v) used %d evaluations", tt.a, tt.b, evals)
+			if evals > tt.maxEvals {
+				t.Errorf("BrentRoot(%v, %v) used %d evaluations, want at most %d", tt.a, tt.b, evals, tt.maxEvals)
+			}
+		})
+	}
+
+	if _, err := BrentRoot(-1, 1, 1e-12, func(x float64) float64 { return x*x + 1 }); err == nil {
+		t.Errorf("BrentRoot() of a range that does not bracket a root returned no error")
+	}
+}
@@ -0,0 +1,133 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+var searchImplementations = []struct {
+	name   string
+	search func(needle int, haystack []int) (int, bool)
+}{
+	{"BinarySearchRecursive", BinarySearchRecursive},
+	{"BinarySearchLinear", BinarySearchLinear},
+	{"ExponentialSearch", ExponentialSearch},
+	{"InterpolationSearch", InterpolationSearch},
+	{"InterpolationSequentialSearch", InterpolationSequentialSearch},
+	{"FibonacciSearch", FibonacciSearch},
+}
+
+func TestSearch_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		haystack := make([]int, rnd.Intn(100))
+		for j := range haystack {
+			switch i % 3 {
+			case 0:
+				haystack[j] = rnd.Intn(50)
+			case 1:
+				haystack[j] = rnd.Intn(1 << 20)
+			default:
+				haystack[j] = rnd.Intn(math.MaxInt) - rnd.Intn(math.MaxInt)
+			}
+		}
+		slices.Sort(haystack)
+		needle := rnd.Intn(60)
+		if len(haystack) > 0 && i%2 == 0 {
+			needle = haystack[rnd.Intn(len(haystack))]
+		}
+		_, want := slices.BinarySearch(haystack, needle)
+
+		for _, impl := range searchImplementations {
+			if impl.name == "BinarySearchRecursive" && len(haystack) == 0 {
+				continue
+			}
+			idx, ok := impl.search(needle, haystack)
+			if ok != want || ok && haystack[idx] != needle {
+				t.Fatalf("%s(%v, %v) = %v, %v, want found %v", impl.name, needle, haystack, idx, ok, want)
+			}
+		}
+	}
+}
+
+func TestExponentialSearchFunc(t *testing.T) {
+	squares := func(i int) (int, bool) { return i * i, true }
+	tests := []struct {
+		name    string
+		needle  int
+		at      func(i int) (int, bool)
+		wantIdx int
+		wantOK  bool
+	}{
+		{"Unbounded first", 0, squares, 0, true},
+		{"Unbounded found", 1 << 20, squares, 1 << 10, true},
+		{"Unbounded missing", 1<<20 + 1, squares, 0, false},
+		{"Bounded found", 49, func(i int) (int, bool) { return i * i, i < 10 }, 7, true},
+		{"Bounded past end", 100, func(i int) (int, bool) { return i * i, i < 10 }, 0, false},
+		{"Empty", 0, func(i int) (int, bool) { return 0, false }, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxProbe := 0
+			idx, ok := ExponentialSearchFunc(tt.needle, func(i int) (int, bool) {
+				maxProbe = max(maxProbe, i)
+				return tt.at(i)
+			})
+			if idx != tt.wantIdx || ok != tt.wantOK {
+				t.Fatalf("ExponentialSearchFunc(%v) = %v, %v, want %v, %v", tt.needle, idx, ok, tt.wantIdx, tt.wantOK)
+			}
+			if ok && maxProbe > 2*idx+1 {
+				t.Errorf("ExponentialSearchFunc(%v) probed position %d, want at most %d", tt.needle, maxProbe, 2*idx+1)
+			}
+		})
+	}
+}
+
+// BenchmarkSearchDistribution compares the searches on haystacks of uniformly
+// distributed and skewed values, with needles picked uniformly or from the
+// first 1% of the haystack.
+func BenchmarkSearchDistribution(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	datasets := []struct {
+		name  string
+		value func(i, n int) int
+	}{
+		{"uniform", func(i, n int) int { return i*1000 + rnd.Intn(1000) }},
+		{"skewed", func(i, n int) int { return int(math.Exp(40 * float64(i) / float64(n))) }},
+	}
+	for _, n := range []int{1000, 1000000} {
+		for _, data := range datasets {
+			haystack := make([]int, n)
+			for i := range haystack {
+				haystack[i] = data.value(i, n)
+			}
+			slices.Sort(haystack)
+			for _, targets := range []struct {
+				name  string
+				limit int
+			}{
+				{"any", n},
+				{"front", max(1, n/100)},
+			} {
+				needles := make([]int, 1024)
+				for i := range needles {
+					needles[i] = haystack[rnd.Intn(targets.limit)]
+				}
+				for _, impl := range searchImplementations {
+					if impl.name == "InterpolationSequentialSearch" && data.name == "skewed" && n > 1000 {
+						// Takes linear time.
+						continue
+					}
+					b.Run(fmt.Sprintf("%s_%s_%s_%d", impl.name, data.name, targets.name, n), func(b *testing.B) {
+						for i := 0; i < b.N; i++ {
+							impl.search(needles[i%len(needles)], haystack)
+						}
+					})
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package goalgorithms
+
+import "sort"
+
+// SortInterface sorts data in ascending order using one of the generic sorts
+// of this package, e.g. SortInterface(data, QuickSortHoareFunc).
+// Only the Less and Swap methods of data are used: the algorithm sorts a
+// slice of indices by comparing the elements they refer to, and the resulting
+// permutation is then applied to data with at most Len()-1 swaps.
+// Stable algorithms stay stable when used through this adapter.
+func SortInterface(data sort.Interface, sortFunc func([]int, func(a, b int) int)) {
+	n := data.Len()
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sortFunc(idx, func(a, b int) int {
+		if data.Less(a, b) {
+			return -1
+		} else if data.Less(b, a) {
+			return 1
+		}
+		return 0
+	})
+
+	// idx[k] is the position of the element that belongs at k.
+	// Walk each cycle of the permutation, swapping elements into place.
+	for i := range idx {
+		cur := i
+		for idx[cur] != i {
+			next := idx[cur]
+			data.Swap(cur, next)
+			idx[cur] = cur
+			cur = next
+		}
+		idx[cur] = cur
+	}
+}
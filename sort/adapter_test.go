@@ -0,0 +1,154 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"encoding/binary"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// byKey sorts records by key only, so that records with equal keys can be
+// told apart to check stability.
+type byKey []struct{ key, seq int }
+
+func (s byKey) Len() int           { return len(s) }
+func (s byKey) Less(i, j int) bool { return s[i].key < s[j].key }
+func (s byKey) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+func TestSortInterface(t *testing.T) {
+	words := strings.Fields("the quick brown fox jumps over the lazy dog and then some")
+	want := make([]string, len(words))
+	copy(want, words)
+	sort.Strings(want)
+
+	records := make(byKey, 200)
+	for i := range records {
+		records[i].key = (i * 7919) % 13
+		records[i].seq = i
+	}
+
+	for _, impl := range Algorithms() {
+		t.Run(impl.Name, func(t *testing.T) {
+			if impl.SortFunc == nil {
+				t.Skipf("%s has no generic variant", impl.Name)
+			}
+			got := make([]string, len(words))
+			copy(got, words)
+			SortInterface(sort.StringSlice(got), impl.SortFunc)
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("SortInterface(%v, %sFunc) = %v, want %v", words, impl.Name, got, want)
+			}
+
+			tosort := make(byKey, len(records))
+			copy(tosort, records)
+			SortInterface(tosort, impl.SortFunc)
+			if !sort.IsSorted(tosort) {
+				t.Fatalf("SortInterface(byKey, %sFunc) did not sort the records", impl.Name)
+			}
+			if !impl.Stable {
+				return
+			}
+			for i := 1; i < len(tosort); i++ {
+				if tosort[i].key == tosort[i-1].key && tosort[i].seq < tosort[i-1].seq {
+					t.Fatalf("SortInterface(byKey, %sFunc) is not stable at %d: %v, %v", impl.Name, i, tosort[i-1], tosort[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSortFunc_Strings(t *testing.T) {
+	words := strings.Fields("pear Apple fig banana apple Cherry date")
+	want := []string{"Apple", "apple", "banana", "Cherry", "date", "fig", "pear"}
+	for _, impl := range []struct {
+		name string
+		sort func([]string, func(a, b string) int)
+	}{
+		{"MergeSortTopDownFunc", MergeSortTopDownFunc},
+		{"MergeSortTiledFunc", MergeSortTiledFunc},
+		{"FunnelSortFunc", FunnelSortFunc},
+		{"InsertionSortSwapOnceFunc", InsertionSortSwapOnceFunc},
+	} {
+		t.Run(impl.name, func(t *testing.T) {
+			got := make([]string, len(words))
+			copy(got, words)
+			impl.sort(got, func(a, b string) int {
+				return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+			})
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s(%v) = %v, want %v", impl.name, words, got, want)
+			}
+		})
+	}
+}
+
+// TestQuickSortHoareFunc_Duplicates checks that Hoare partitioning splits runs
+// of equal values evenly, by counting the comparisons made on inputs with few
+// distinct values, which take quadratic time otherwise.
+func TestQuickSortHoareFunc_Duplicates(t *testing.T) {
+	const n = 1 << 12
+	for _, impl := range []struct {
+		name string
+		sort func([]int, func(a, b int) int)
+	}{
+		{"QuickSortHoareFunc", QuickSortHoareFunc},
+		{"QuickSortHoareM3Func", QuickSortHoareM3Func},
+	} {
+		for _, distinct := range []int{1, 2, 10} {
+			list := make([]int, n)
+			for i := range list {
+				list[i] = i * 7919 % distinct
+			}
+			comparisons := 0
+			impl.sort(list, func(a, b int) int {
+				comparisons++
+				return cmp.Compare(a, b)
+			})
+			if !slices.IsSorted(list) {
+				t.Fatalf("%s() did not sort %d distinct values", impl.name, distinct)
+			}
+			// A quadratic sort makes millions of comparisons here.
+			if limit := 4 * n * 12; comparisons > limit {
+				t.Errorf("%s() made %d comparisons on %d distinct values, want at most %d", impl.name, comparisons, distinct, limit)
+			}
+		}
+	}
+}
+
+// FuzzSortFunc compares every generic sort and the sort.Interface adapter
+// against slices.Sort on inputs decoded from the fuzzer's bytes. Values are
+// decoded from two bytes each so that duplicates are common.
+func FuzzSortFunc(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 0})
+	f.Add([]byte{9, 0, 8, 0, 7, 0, 1, 0, 1, 0, 1, 0})
+	f.Add([]byte{0xff, 0xff, 0, 0, 0x80, 0, 0, 0x80, 3, 3, 3, 3})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		list := make([]int, len(data)/2)
+		for i := range list {
+			list[i] = int(int16(binary.LittleEndian.Uint16(data[2*i:])))
+		}
+		want := slices.Clone(list)
+		slices.Sort(want)
+
+		for _, impl := range Algorithms() {
+			if impl.SortFunc == nil {
+				continue
+			}
+			got := slices.Clone(list)
+			impl.SortFunc(got, cmp.Compare[int])
+			if !slices.Equal(got, want) {
+				t.Fatalf("%sFunc(%v) = %v, want %v", impl.Name, list, got, want)
+			}
+
+			got = slices.Clone(list)
+			SortInterface(sort.IntSlice(got), impl.SortFunc)
+			if !slices.Equal(got, want) {
+				t.Fatalf("SortInterface(%v, %sFunc) = %v, want %v", list, impl.Name, got, want)
+			}
+		}
+	})
+}
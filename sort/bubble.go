@@ -1,14 +1,29 @@
 package goalgorithms
 
+import "cmp"
+
 // BubbleSort is an implementation of bubble sort with one loop.
 // Sorts the int slice in-place in ascending order.
 // Worst-case time compexity: O(n^2).
 // Don't ever use in production. For small sets use insertion or selection sort instead.
 func BubbleSort(a []int) {
+	BubbleSortFunc(a, cmp.Compare[int])
+}
+
+// BubbleSortTwoLoops is an implementation of bubble sort with two loops.
+// Sorts the int slice in-place in ascending order.
+func BubbleSortTwoLoops(a []int) {
+	BubbleSortTwoLoopsFunc(a, cmp.Compare[int])
+}
+
+// BubbleSortFunc is a generic variant of BubbleSort that sorts a in ascending
+// order as determined by cmp. cmp(a, b) should return a negative number when
+// a < b, a positive number when a > b and zero when a == b.
+func BubbleSortFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
 	n := len(a)
 	i := 1
 	for i < n {
-		if a[i] < a[i-1] {
+		if cmp(a[i], a[i-1]) < 0 {
 			a[i], a[i-1] = a[i-1], a[i]
 		}
 		i++
@@ -19,12 +34,12 @@ func BubbleSort(a []int) {
 	}
 }
 
-// BubbleSortTwoLoops is an implementation of bubble sort with two loops.
-// Sorts the int slice in-place in ascending order.
-func BubbleSortTwoLoops(a []int) {
+// BubbleSortTwoLoopsFunc is a generic variant of BubbleSortTwoLoops that sorts
+// a in ascending order as determined by cmp.
+func BubbleSortTwoLoopsFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
 	for n := len(a); n > 0; n-- {
 		for i := 1; i < n; i++ {
-			if a[i] < a[i-1] {
+			if cmp(a[i], a[i-1]) < 0 {
 				a[i], a[i-1] = a[i-1], a[i]
 			}
 		}
@@ -0,0 +1,60 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"context"
+	"testing"
+
+	sorttest "github.com/quasoft/goalgorithms/sort/sorttest"
+)
+
+func init() {
+	for _, a := range Algorithms() {
+		if a.SortFunc == nil {
+			// Stability can only be checked through the generic variant.
+			sorttest.Register(sorttest.Algorithm{Name: a.Name, Sort: a.Sort})
+			continue
+		}
+		sorttest.Register(sorttest.Algorithm{Name: a.Name, Sort: a.Sort, SortFunc: recordSortFunc(a), Stable: a.Stable})
+	}
+	for _, a := range []sorttest.Algorithm{
+		{Name: "QuickSortHoareContext", Sort: func(a []int) { QuickSortHoareContext(context.Background(), a, nil) }},
+		{Name: "QuickSortHoareM3Context", Sort: func(a []int) { QuickSortHoareM3Context(context.Background(), a, nil) }},
+		{Name: "QuickSortLomutoContext", Sort: func(a []int) { QuickSortLomutoContext(context.Background(), a, nil) }},
+		{Name: "MergeSortBottomUpContext", Sort: func(a []int) { MergeSortBottomUpContext(context.Background(), a, nil) }},
+	} {
+		sorttest.Register(a)
+	}
+	for _, p := range pivotStrategies {
+		pivot := p.pivot
+		hoare := QuickSorter[int]{Partition: PartitionHoare, Pivot: pivot, Cmp: cmp.Compare[int]}
+		lomuto := QuickSorter[int]{Partition: PartitionLomuto, Pivot: pivot, Cmp: cmp.Compare[int]}
+		sorttest.Register(sorttest.Algorithm{Name: "QuickSorterHoare" + p.name, Sort: hoare.Sort})
+		sorttest.Register(sorttest.Algorithm{Name: "QuickSorterLomuto" + p.name, Sort: lomuto.Sort})
+	}
+}
+
+// recordSortFunc sorts records with the generic variant of a by sorting their
+// indices, the same way SortInterface does.
+func recordSortFunc(a Algorithm) func([]sorttest.Record, func(a, b sorttest.Record) int) {
+	return func(records []sorttest.Record, cmp func(a, b sorttest.Record) int) {
+		SortInterface(recordSlice{records, cmp}, a.SortFunc)
+	}
+}
+
+type recordSlice struct {
+	records []sorttest.Record
+	cmp     func(a, b sorttest.Record) int
+}
+
+func (s recordSlice) Len() int           { return len(s.records) }
+func (s recordSlice) Less(i, j int) bool { return s.cmp(s.records[i], s.records[j]) < 0 }
+func (s recordSlice) Swap(i, j int)      { s.records[i], s.records[j] = s.records[j], s.records[i] }
+
+func TestConformance(t *testing.T) {
+	sorttest.Run(t)
+}
+
+func FuzzSort(f *testing.F) {
+	sorttest.Fuzz(f)
+}
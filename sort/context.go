@@ -0,0 +1,110 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"context"
+)
+
+// ProgressFunc receives an estimate of the fraction of sorting work done,
+// in the range from 0 to 1. Calls are made from the sorting goroutine and
+// the reported values never decrease.
+type ProgressFunc func(done float64)
+
+// progressSteps limits how often a ProgressFunc is called during one sort.
+const progressSteps = 100
+
+// ctxSorter holds the state shared by the context-aware sorts: the context
+// to check at partition or merge boundaries and the progress bookkeeping.
+type ctxSorter struct {
+	ctx      context.Context
+	progress ProgressFunc
+	total    int
+	done     int
+	reported int
+}
+
+func newCtxSorter(ctx context.Context, total int, progress ProgressFunc) *ctxSorter {
+	return &ctxSorter{ctx: ctx, progress: progress, total: total}
+}
+
+// check returns the context error if the context has been canceled.
+// A nil sorter is never canceled.
+func (s *ctxSorter) check() error {
+	if s == nil {
+		return nil
+	}
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	default:
+		return nil
+	}
+}
+
+// advance records that k more units of work out of total are done and calls
+// the progress function if the fraction has moved by at least one step.
+// It does nothing on a nil sorter.
+func (s *ctxSorter) advance(k int) {
+	if s == nil {
+		return
+	}
+	s.done += k
+	if s.progress == nil || s.total == 0 {
+		return
+	}
+	step := s.done * progressSteps / s.total
+	if step > s.reported {
+		s.reported = step
+		s.progress(float64(s.done) / float64(s.total))
+	}
+}
+
+// finish reports completion unless it was already reported by advance.
+func (s *ctxSorter) finish() {
+	if s.progress != nil && s.reported < progressSteps {
+		s.reported = progressSteps
+		s.progress(1)
+	}
+}
+
+// QuickSortHoareContext is a variant of QuickSortHoare that can be interrupted.
+// The context is checked before sorting and before each partition step. If it
+// is done, sorting stops and ctx.Err() is returned, leaving a as a permutation
+// of its original values. If progress is not nil, it is called with the
+// estimated fraction of elements that have reached their final position.
+func QuickSortHoareContext(ctx context.Context, a []int, progress ProgressFunc) error {
+	return QuickSorter[int]{Partition: PartitionHoare, Pivot: PivotMiddle[int], Cmp: cmp.Compare[int]}.SortContext(ctx, a, progress)
+}
+
+// QuickSortHoareM3Context is a variant of QuickSortHoareM3 that can be interrupted.
+// See QuickSortHoareContext for the meaning of ctx and progress.
+func QuickSortHoareM3Context(ctx context.Context, a []int, progress ProgressFunc) error {
+	return QuickSorter[int]{Partition: PartitionHoare, Pivot: PivotMedianOfThree[int], Cmp: cmp.Compare[int]}.SortContext(ctx, a, progress)
+}
+
+// QuickSortLomutoContext is a variant of QuickSortLomuto that can be interrupted.
+// See QuickSortHoareContext for the meaning of ctx and progress.
+func QuickSortLomutoContext(ctx context.Context, a []int, progress ProgressFunc) error {
+	return QuickSorter[int]{Partition: PartitionLomuto, Pivot: PivotLast[int], Cmp: cmp.Compare[int]}.SortContext(ctx, a, progress)
+}
+
+// MergeSortBottomUpContext is a variant of MergeSortBottomUp2 that can be interrupted.
+// The context is checked before sorting and before each merge of two runs.
+// Merged runs are copied back before the next check, so on cancellation a is
+// still a permutation of its original values. Progress is estimated from the
+// number of elements merged over all passes.
+func MergeSortBottomUpContext(ctx context.Context, a []int, progress ProgressFunc) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	passes := 0
+	for size := 1; size < len(a); size *= 2 {
+		passes++
+	}
+	s := newCtxSorter(ctx, passes*len(a), progress)
+	if err := mergeSortBottomUp2Func(s, a, cmp.Compare[int]); err != nil {
+		return err
+	}
+	s.finish()
+	return nil
+}
@@ -0,0 +1,106 @@
+package goalgorithms
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+var contextImplementations = []struct {
+	name string
+	sort func(context.Context, []int, ProgressFunc) error
+}{
+	{"QuickSortHoareContext", QuickSortHoareContext},
+	{"QuickSortHoareM3Context", QuickSortHoareM3Context},
+	{"QuickSortLomutoContext", QuickSortLomutoContext},
+	{"MergeSortBottomUpContext", MergeSortBottomUpContext},
+}
+
+func TestSortContext(t *testing.T) {
+	list := rand.New(rand.NewSource(1)).Perm(1000)
+	want := make([]int, len(list))
+	copy(want, list)
+	sort.Ints(want)
+
+	for _, impl := range contextImplementations {
+		t.Run(impl.name, func(t *testing.T) {
+			tosort := make([]int, len(list))
+			copy(tosort, list)
+
+			var reported []float64
+			err := impl.sort(context.Background(), tosort, func(done float64) {
+				reported = append(reported, done)
+			})
+			if err != nil {
+				t.Fatalf("%s() = %v, want nil", impl.name, err)
+			}
+			if !reflect.DeepEqual(tosort, want) {
+				t.Fatalf("%s() did not sort the slice: %v", impl.name, tosort)
+			}
+			if len(reported) == 0 || reported[len(reported)-1] != 1 {
+				t.Fatalf("%s() reported progress %v, want last value 1", impl.name, reported)
+			}
+			for i := 1; i < len(reported); i++ {
+				if reported[i] < reported[i-1] {
+					t.Fatalf("%s() reported decreasing progress %v", impl.name, reported)
+				}
+			}
+		})
+	}
+}
+
+func TestSortContext_Canceled(t *testing.T) {
+	list := rand.New(rand.NewSource(2)).Perm(1000)
+	want := make([]int, len(list))
+	copy(want, list)
+	sort.Ints(want)
+
+	for _, impl := range contextImplementations {
+		t.Run(impl.name, func(t *testing.T) {
+			tosort := make([]int, len(list))
+			copy(tosort, list)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			err := impl.sort(ctx, tosort, func(done float64) {
+				if done >= 0.3 {
+					cancel()
+				}
+			})
+			if err != context.Canceled {
+				t.Fatalf("%s() = %v, want %v", impl.name, err, context.Canceled)
+			}
+
+			got := make([]int, len(tosort))
+			copy(got, tosort)
+			sort.Ints(got)
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s() left a slice that is not a permutation of the input", impl.name)
+			}
+		})
+	}
+}
+
+func TestSortContext_AlreadyCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, impl := range contextImplementations {
+		t.Run(impl.name, func(t *testing.T) {
+			list := []int{3, 1, 2}
+			if err := impl.sort(ctx, list, nil); err != context.Canceled {
+				t.Fatalf("%s() = %v, want %v", impl.name, err, context.Canceled)
+			}
+			if !reflect.DeepEqual(list, []int{3, 1, 2}) {
+				t.Fatalf("%s() modified the slice before checking the context: %v", impl.name, list)
+			}
+			// Slices that need no sorting must report the error too.
+			for _, short := range [][]int{nil, {1}} {
+				if err := impl.sort(ctx, short, nil); err != context.Canceled {
+					t.Fatalf("%s(%v) = %v, want %v", impl.name, short, err, context.Canceled)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package goalgorithms
+
+import "cmp"
+
+// cycleSort sorts a by splitting the permutation into cycles and rotating
+// each cycle in place. Every value is written once, directly into its final
+// position, so positions that already hold the right value are never written.
+// All writes go through write, reads are done on a directly.
+func cycleSort[E any](a []E, cmp func(a, b E) int, write func(i int, v E)) {
+	for start := 0; start < len(a)-1; start++ {
+		item := a[start]
+
+		// The final position of item is after all smaller values.
+		pos := start
+		for i := start + 1; i < len(a); i++ {
+			if cmp(a[i], item) < 0 {
+				pos++
+			}
+		}
+		if pos == start {
+			continue
+		}
+
+		for pos != start {
+			// Skip values equal to item that are already in place.
+			for cmp(item, a[pos]) == 0 {
+				pos++
+			}
+			next := a[pos]
+			write(pos, item)
+			item = next
+
+			pos = start
+			for i := start + 1; i < len(a); i++ {
+				if cmp(a[i], item) < 0 {
+					pos++
+				}
+			}
+		}
+		write(start, item)
+	}
+}
+
+// CycleSort performs in-place sort of int slice in ascending order with the
+// minimum possible number of writes: every element that is not already in its
+// final position is written exactly once, and no other element is written.
+// Useful when writes are much more expensive than reads, e.g. on flash memory.
+// Worst case time compexity: O(n^2)
+// Worst case space compexity: O(1)
+func CycleSort(a []int) {
+	CycleSortFunc(a, cmp.Compare[int])
+}
+
+// CycleSortWrites sorts a like CycleSort and returns the number of writes made to a.
+func CycleSortWrites(a []int) int {
+	writes := 0
+	cycleSort(a, cmp.Compare[int], func(i, v int) {
+		a[i] = v
+		writes++
+	})
+	return writes
+}
+
+// CycleSortFunc is a generic variant of CycleSort that sorts a in ascending
+// order as determined by cmp.
+func CycleSortFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	cycleSort(a, cmp, func(i int, v E) { a[i] = v })
+}
@@ -0,0 +1,72 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestCycleSort_WritesEachElementOnce(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 10, 100, 500} {
+		for _, distinct := range []int{1, 3, n + 1} {
+			t.Run(fmt.Sprintf("%d values of %d", n, distinct), func(t *testing.T) {
+				list := make([]int, n)
+				for i := range list {
+					list[i] = rnd.Intn(distinct)
+				}
+				want := slices.Clone(list)
+				slices.Sort(want)
+
+				got := slices.Clone(list)
+				writes := make([]int, n)
+				cycleSort(got, cmp.Compare[int], func(i, v int) {
+					got[i] = v
+					writes[i]++
+				})
+				if !slices.Equal(got, want) {
+					t.Fatalf("cycleSort(%v) = %v, want %v", list, got, want)
+				}
+
+				for i, w := range writes {
+					if w > 1 {
+						t.Fatalf("cycleSort(%v) wrote position %d %d times", list, i, w)
+					}
+					// Only positions that did not already hold their final
+					// value may be written.
+					if w == 1 && list[i] == want[i] || w == 0 && list[i] != want[i] {
+						t.Fatalf("cycleSort(%v) wrote position %d %d times, but it held %d and should hold %d", list, i, w, list[i], want[i])
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestSortWrites(t *testing.T) {
+	tests := []struct {
+		name       string
+		list       []int
+		wantCycle  int
+		wantSelect int
+	}{
+		{"Empty", []int{}, 0, 0},
+		{"Already sorted", []int{1, 2, 3, 4, 5}, 0, 0},
+		{"One swap", []int{1, 2, 4, 3, 5}, 2, 2},
+		{"Rotation", []int{2, 3, 4, 5, 1}, 5, 8},
+		{"Reversed", []int{5, 4, 3, 2, 1}, 4, 4},
+		{"Duplicates", []int{2, 1, 2, 1, 2}, 2, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CycleSortWrites(slices.Clone(tt.list)); got != tt.wantCycle {
+				t.Errorf("CycleSortWrites(%v) = %d, want %d", tt.list, got, tt.wantCycle)
+			}
+			if got := SelectionSortMinSwapWrites(slices.Clone(tt.list)); got != tt.wantSelect {
+				t.Errorf("SelectionSortMinSwapWrites(%v) = %d, want %d", tt.list, got, tt.wantSelect)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"math"
+	"math/bits"
+)
+
+// minMax returns the smallest and largest value in a, which must not be empty.
+func minMax(a []int) (int, int) {
+	lo, hi := a[0], a[0]
+	for _, v := range a[1:] {
+		if v < lo {
+			lo = v
+		} else if v > hi {
+			hi = v
+		}
+	}
+	return lo, hi
+}
+
+// classIndex maps v from the range [lo, lo+span] linearly onto one of n
+// classes. The arithmetic is done on 128 bits, so any int range works.
+func classIndex(v, lo int, span uint64, n int) int {
+	hi, low := bits.Mul64(uint64(v-lo), uint64(n))
+	if span == math.MaxUint64 {
+		return int(hi)
+	}
+	q, _ := bits.Div64(hi, low, span+1)
+	return int(q)
+}
+
+// BucketSort performs a stable sort of int slice in ascending order using
+// len(a) buckets. See BucketSortN.
+func BucketSort(a []int) {
+	BucketSortN(a, len(a))
+}
+
+// BucketSortN performs a stable sort of int slice in ascending order by
+// distributing the values into the given number of equally wide buckets
+// between the smallest and largest value, and sorting each bucket with
+// insertion sort.
+// Fast on uniformly distributed values, where buckets hold few elements.
+// Best and average time compexity on uniform data: O(n)
+// Worst case time compexity: O(n^2), when most values fall in one bucket.
+// Worst case space compexity: O(n + buckets)
+func BucketSortN(a []int, buckets int) {
+	if len(a) < 2 {
+		return
+	}
+	if buckets < 1 {
+		buckets = 1
+	}
+	lo, hi := minMax(a)
+	if lo == hi {
+		return
+	}
+	span := uint64(hi - lo)
+
+	// Count the size of each bucket and turn the counts into start offsets,
+	// then copy the values to a buffer in bucket order.
+	start := make([]int, buckets+1)
+	for _, v := range a {
+		start[classIndex(v, lo, span, buckets)+1]++
+	}
+	for k := 1; k <= buckets; k++ {
+		start[k] += start[k-1]
+	}
+	b := make([]int, len(a))
+	next := make([]int, buckets)
+	copy(next, start)
+	for _, v := range a {
+		k := classIndex(v, lo, span, buckets)
+		b[next[k]] = v
+		next[k]++
+	}
+
+	for k := 0; k < buckets; k++ {
+		InsertionSortSwapOnce(b[start[k]:start[k+1]])
+	}
+	copy(a, b)
+}
+
+// FlashSort performs in-place sort of int slice in ascending order using
+// Neubert's flashsort. Values are classified into 0.43n classes by linear
+// interpolation between the smallest and largest value, moved into their
+// class with an in-place permutation, and the almost sorted result is
+// finished with insertion sort.
+// The permutation swaps elements into their class the way American flag sort
+// does, rather than with Neubert's cycle leader search.
+// Best and average time compexity on uniform data: O(n)
+// Worst case time compexity: O(n^2), when most values fall in one class.
+// Worst case space compexity: O(n), for 0.43n class counters.
+func FlashSort(a []int) {
+	if len(a) < 2 {
+		return
+	}
+	lo, hi := minMax(a)
+	if lo == hi {
+		return
+	}
+	span := uint64(hi - lo)
+	m := max(2, int(0.43*float64(len(a))))
+
+	end := make([]int, m)
+	for _, v := range a {
+		end[classIndex(v, lo, span, m)]++
+	}
+	for k := 1; k < m; k++ {
+		end[k] += end[k-1]
+	}
+
+	// next[k] is the first position of class k that does not yet hold
+	// an element of class k.
+	next := make([]int, m)
+	for k := 1; k < m; k++ {
+		next[k] = end[k-1]
+	}
+	for k := 0; k < m; k++ {
+		for next[k] < end[k] {
+			v := a[next[k]]
+			c := classIndex(v, lo, span, m)
+			if c == k {
+				next[k]++
+				continue
+			}
+			a[next[k]], a[next[c]] = a[next[c]], v
+			next[c]++
+		}
+	}
+
+	InsertionSortSwapOnce(a)
+}
+
+// Parameters of spreadSort.
+const (
+	// spreadSortMin is the size below which a bin is sorted by comparison.
+	spreadSortMin = 256
+	// spreadSortMaxBits limits the number of bins of one radix step to 2^11.
+	spreadSortMaxBits = 11
+)
+
+// spreadSort sorts a by the keys returned by key, which must order the
+// elements the same way as the elements themselves. Each step splits the
+// range of keys into up to 2^11 bins by their highest bits, moves elements
+// into their bins in-place and continues in each bin, until bins are small
+// enough to be sorted by comparison with sortSmall.
+func spreadSort[E any](a []E, key func(E) uint64, sortSmall func([]E)) {
+	if len(a) < spreadSortMin {
+		sortSmall(a)
+		return
+	}
+
+	lo, hi := key(a[0]), key(a[0])
+	for _, v := range a[1:] {
+		k := key(v)
+		if k < lo {
+			lo = k
+		} else if k > hi {
+			hi = k
+		}
+	}
+	if lo == hi {
+		return
+	}
+
+	// Use about n/4 bins, so that bins hold a few elements on average.
+	logBins := min(bits.Len(uint(len(a)))-2, spreadSortMaxBits)
+	shift := max(bits.Len64(hi-lo)-logBins, 0)
+	bins := int((hi-lo)>>shift) + 1
+
+	end := make([]int, bins)
+	for _, v := range a {
+		end[(key(v)-lo)>>shift]++
+	}
+	for b := 1; b < bins; b++ {
+		end[b] += end[b-1]
+	}
+	next := make([]int, bins)
+	for b := 1; b < bins; b++ {
+		next[b] = end[b-1]
+	}
+	for b := 0; b < bins; b++ {
+		for next[b] < end[b] {
+			v := a[next[b]]
+			c := int((key(v) - lo) >> shift)
+			if c == b {
+				next[b]++
+				continue
+			}
+			a[next[b]], a[next[c]] = a[next[c]], v
+			next[c]++
+		}
+	}
+
+	if shift == 0 {
+		// Every bin holds a single key.
+		return
+	}
+	start := 0
+	for b := 0; b < bins; b++ {
+		spreadSort(a[start:end[b]], key, sortSmall)
+		start = end[b]
+	}
+}
+
+// SpreadSort performs in-place sort of int slice in ascending order using a
+// hybrid of most significant digit radix sort and comparison sort, modelled
+// on Boost's spreadsort. Radix steps split the range of values until the
+// parts are small, which are then sorted with QuickSortHoareM3.
+// Best time compexity: O(n)
+// Worst case time compexity: O(n log(n))
+// Worst case space compexity: O(n), for the bin counters. A radix step uses
+// up to n/2 bins, but never more than 2^11, and keeps them while its bins
+// are sorted.
+func SpreadSort(a []int) {
+	spreadSort(a, func(v int) uint64 {
+		// Flipping the sign bit orders negative values before positive ones.
+		return uint64(v) ^ 1<<63
+	}, QuickSortHoareM3)
+}
+
+// float64Key maps a float64 to a uint64 with the same order, for all values
+// other than NaN.
+func float64Key(f float64) uint64 {
+	b := math.Float64bits(f)
+	if b&(1<<63) != 0 {
+		return ^b
+	}
+	return b | 1<<63
+}
+
+// SpreadSortFloat64 sorts a float64 slice in ascending order like SpreadSort.
+// NaNs are moved to the start of the slice, as slices.Sort does.
+func SpreadSortFloat64(a []float64) {
+	nans := 0
+	for i, f := range a {
+		if f != f {
+			a[i], a[nans] = a[nans], a[i]
+			nans++
+		}
+	}
+	spreadSort(a[nans:], float64Key, func(a []float64) {
+		QuickSortHoareM3Func(a, cmp.Compare[float64])
+	})
+}
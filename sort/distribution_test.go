@@ -0,0 +1,179 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestClassIndex(t *testing.T) {
+	tests := []struct {
+		v, lo int
+		span  uint64
+		n     int
+		want  int
+	}{
+		{0, 0, 9, 10, 0},
+		{9, 0, 9, 10, 9},
+		{5, 0, 9, 2, 1},
+		{4, 0, 9, 2, 0},
+		{math.MinInt, math.MinInt, math.MaxUint64, 4, 0},
+		{math.MaxInt, math.MinInt, math.MaxUint64, 4, 3},
+		{0, math.MinInt, math.MaxUint64, 4, 2},
+		{-1, math.MinInt, math.MaxUint64, 4, 1},
+	}
+	for _, tt := range tests {
+		if got := classIndex(tt.v, tt.lo, tt.span, tt.n); got != tt.want {
+			t.Errorf("classIndex(%d, %d, %d, %d) = %d, want %d", tt.v, tt.lo, tt.span, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestBucketSortN(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	list := make([]int, 500)
+	for i := range list {
+		list[i] = rnd.Intn(200) - 100
+	}
+	list[0], list[1] = math.MinInt, math.MaxInt
+	want := slices.Clone(list)
+	slices.Sort(want)
+	for _, buckets := range []int{0, 1, 3, 64, 500, 5000} {
+		t.Run(fmt.Sprint(buckets), func(t *testing.T) {
+			got := slices.Clone(list)
+			BucketSortN(got, buckets)
+			if !slices.Equal(got, want) {
+				t.Fatalf("BucketSortN(list, %d) did not sort the list: %v", buckets, got)
+			}
+		})
+	}
+}
+
+func TestSpreadSort_Large(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for name, gen := range map[string]func() int{
+		"Full range":  func() int { return int(rnd.Uint64()) },
+		"Small range": func() int { return rnd.Intn(100) },
+		"Extremes": func() int {
+			return []int{math.MinInt, math.MaxInt, 0, rnd.Int()}[rnd.Intn(4)]
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			list := make([]int, 100000)
+			for i := range list {
+				list[i] = gen()
+			}
+			want := slices.Clone(list)
+			slices.Sort(want)
+			SpreadSort(list)
+			if !slices.Equal(list, want) {
+				t.Fatalf("SpreadSort() did not sort the list")
+			}
+		})
+	}
+}
+
+func TestSpreadSortFloat64(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	list := []float64{math.Inf(1), math.NaN(), -1.5, 0, math.Copysign(0, -1), math.Inf(-1), math.SmallestNonzeroFloat64,
+		-math.MaxFloat64, math.MaxFloat64, math.NaN(), 2.25, -1.5}
+	for i := 0; i < 10000; i++ {
+		list = append(list, rnd.NormFloat64()*1e6)
+	}
+	got := slices.Clone(list)
+	SpreadSortFloat64(got)
+	if !slices.IsSorted(got) {
+		t.Fatalf("SpreadSortFloat64() did not sort the list")
+	}
+	if !math.IsNaN(got[0]) || !math.IsNaN(got[1]) || math.IsNaN(got[2]) {
+		t.Errorf("SpreadSortFloat64() did not move the NaNs to the start: %v", got[:3])
+	}
+	want := slices.Clone(list)
+	slices.Sort(want)
+	for i := range got {
+		if got[i] != want[i] && !(math.IsNaN(got[i]) && math.IsNaN(want[i])) {
+			t.Fatalf("SpreadSortFloat64() at %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+// distributions generate n values with different shapes. Skewed values are
+// crowded near zero with a long tail, which puts most of them in one bucket.
+var distributions = []struct {
+	name string
+	gen  func(rnd *rand.Rand, n int) []int
+}{
+	{"uniform", func(rnd *rand.Rand, n int) []int {
+		list := make([]int, n)
+		for i := range list {
+			list[i] = rnd.Intn(1 << 30)
+		}
+		return list
+	}},
+	{"normal", func(rnd *rand.Rand, n int) []int {
+		list := make([]int, n)
+		for i := range list {
+			list[i] = int(rnd.NormFloat64() * (1 << 20))
+		}
+		return list
+	}},
+	{"skewed", func(rnd *rand.Rand, n int) []int {
+		list := make([]int, n)
+		for i := range list {
+			list[i] = int(math.Pow(rnd.ExpFloat64(), 6) * 1000)
+		}
+		return list
+	}},
+}
+
+func BenchmarkDistributionSort(b *testing.B) {
+	const n = 100000
+	sorts := []struct {
+		name string
+		sort func([]int)
+	}{
+		{"BucketSort", BucketSort},
+		{"FlashSort", FlashSort},
+		{"SpreadSort", SpreadSort},
+		{"QuickSortHoareM3", QuickSortHoareM3},
+		{"MergeSortBottomUp2", MergeSortBottomUp2},
+	}
+	tosort := make([]int, n)
+	for _, d := range distributions {
+		list := d.gen(rand.New(rand.NewSource(1)), n)
+		for _, s := range sorts {
+			b.Run(fmt.Sprintf("%s_%s_%d", s.name, d.name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					copy(tosort, list)
+					s.sort(tosort)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkSpreadSortFloat64(b *testing.B) {
+	const n = 100000
+	rnd := rand.New(rand.NewSource(1))
+	list := make([]float64, n)
+	for i := range list {
+		list[i] = rnd.NormFloat64()
+	}
+	tosort := make([]float64, n)
+	for _, s := range []struct {
+		name string
+		sort func([]float64)
+	}{
+		{"SpreadSortFloat64", SpreadSortFloat64},
+		{"slices.Sort", slices.Sort[[]float64]},
+	} {
+		b.Run(fmt.Sprintf("%s_normal_%d", s.name, n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(tosort, list)
+				s.sort(tosort)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"math"
+)
+
+// Go implementation of lazy funnelsort as described by Brodal and Fagerberg in
+// "Cache Oblivious Distribution Sweeping" (ICALP 2002).
+
+// funnelSortBase is the size below which funnelSort falls back to insertion sort.
+const funnelSortBase = 64
+
+// funnelFunc is a node of a k-funnel: a binary tree of mergers, where every
+// node has an output buffer that is lazily refilled from its two children when
+// it runs empty. Leaves hold the sorted input runs as their buffers.
+type funnelFunc[E any] struct {
+	left, right *funnelFunc[E]
+	buf         []E
+	lo, hi      int
+	// done is set once the node can produce no more elements.
+	done bool
+	cmp  func(a, b E) int
+}
+
+// fill merges elements from the children into the buffer of f until the
+// buffer is full or both children are exhausted.
+func (f *funnelFunc[E]) fill() {
+	f.lo, f.hi = 0, 0
+	l, r := f.left, f.right
+	for f.hi < len(f.buf) {
+		if l.lo == l.hi && !l.done {
+			l.fill()
+		}
+		if r.lo == r.hi && !r.done {
+			r.fill()
+		}
+		lempty := l.lo == l.hi
+		rempty := r.lo == r.hi
+		if lempty && rempty {
+			f.done = true
+			return
+		}
+		if !lempty && (rempty || f.cmp(l.buf[l.lo], r.buf[r.lo]) <= 0) {
+			f.buf[f.hi] = l.buf[l.lo]
+			l.lo++
+		} else {
+			f.buf[f.hi] = r.buf[r.lo]
+			r.lo++
+		}
+		f.hi++
+	}
+}
+
+// newFunnelFunc builds a funnel over the sorted runs. The buffer of a node
+// with d leaves below it holds d^(3/2) elements, which is the size used
+// between the top and bottom trees in the recursive definition of a k-funnel.
+// Unlike the original, nodes are separate allocations rather than being
+// laid out in van Emde Boas order in one block of memory.
+func newFunnelFunc[E any](runs [][]E, cmp func(a, b E) int) *funnelFunc[E] {
+	if len(runs) == 1 {
+		return &funnelFunc[E]{buf: runs[0], hi: len(runs[0]), done: true}
+	}
+	m := len(runs) / 2
+	f := &funnelFunc[E]{left: newFunnelFunc(runs[:m], cmp), right: newFunnelFunc(runs[m:], cmp), cmp: cmp}
+	total := 0
+	for _, run := range runs {
+		total += len(run)
+	}
+	size := int(math.Ceil(math.Pow(float64(len(runs)), 1.5)))
+	f.buf = make([]E, min(size, total))
+	return f
+}
+
+// funnelSortFunc sorts a using b as scratch space of the same length. It adds
+// the length of every run that it sorts or merges to elements, so that tests
+// can count the passes it makes over the slice.
+func funnelSortFunc[E any](a, b []E, cmp func(a, b E) int, elements *int) {
+	n := len(a)
+	if n <= funnelSortBase {
+		InsertionSortSwapOnceFunc(a, cmp)
+		*elements += n
+		return
+	}
+
+	// Split into k = n^(1/3) runs of n^(2/3) elements, sort them recursively
+	// and merge them with a k-funnel.
+	k := int(math.Ceil(math.Cbrt(float64(n))))
+	size := (n + k - 1) / k
+	runs := make([][]E, 0, k)
+	for left := 0; left < n; left += size {
+		right := min(left+size, n)
+		funnelSortFunc(a[left:right], b[left:right], cmp, elements)
+		runs = append(runs, a[left:right])
+	}
+
+	root := newFunnelFunc(runs, cmp)
+	root.buf = b[:n]
+	root.fill()
+	copy(a, b)
+	*elements += n
+}
+
+// FunnelSort performs a stable in-place sort of int slice in ascending order
+// using lazy funnelsort.
+// Funnelsort is cache-oblivious: it makes an asymptotically optimal number of
+// cache misses for any cache size without knowing it. It is meant as a
+// reference to compare cache-aware sorts like MergeSortTiled against.
+// Worst case time compexity: O(n log(n))
+// Worst case space compexity: O(n)
+func FunnelSort(a []int) {
+	FunnelSortFunc(a, cmp.Compare[int])
+}
+
+// FunnelSortFunc is a generic variant of FunnelSort that sorts a in ascending
+// order as determined by cmp.
+func FunnelSortFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	b := make([]E, len(a), len(a))
+	var elements int
+	funnelSortFunc(a, b, cmp, &elements)
+}
@@ -1,26 +1,45 @@
 package goalgorithms
 
+import "cmp"
+
 // InsertionSortSwap sorts an int slice in ascending order by swapping values.
 // Worst case time compexity: O(n^2)
 // Worst case space compexity: O(n)
 func InsertionSortSwap(a []int) {
+	InsertionSortSwapFunc(a, cmp.Compare[int])
+}
+
+// InsertionSortSwapOnce sorts an int slice in ascending order by swapping once in the inner loop.
+// Worst case time compexity: O(n^2)
+// Worst case space compexity: O(n)
+func InsertionSortSwapOnce(a []int) {
+	InsertionSortSwapOnceFunc(a, cmp.Compare[int])
+}
+
+// InsertionSortShift sorts an int slice in ascending order by shifting values.
+func InsertionSortShift(a []int) {
+	InsertionSortShiftFunc(a, cmp.Compare[int])
+}
+
+// InsertionSortSwapFunc is a generic variant of InsertionSortSwap that sorts
+// a in ascending order as determined by cmp.
+func InsertionSortSwapFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
 	for i := 1; i < len(a); i++ {
 		k := i
-		for k > 0 && a[k] < a[k-1] {
+		for k > 0 && cmp(a[k], a[k-1]) < 0 {
 			a[k], a[k-1] = a[k-1], a[k]
 			k--
 		}
 	}
 }
 
-// InsertionSortSwapOnce sorts an int slice in ascending order by swapping once in the inner loop.
-// Worst case time compexity: O(n^2)
-// Worst case space compexity: O(n)
-func InsertionSortSwapOnce(a []int) {
+// InsertionSortSwapOnceFunc is a generic variant of InsertionSortSwapOnce that
+// sorts a in ascending order as determined by cmp.
+func InsertionSortSwapOnceFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
 	for i := 1; i < len(a); i++ {
 		v := a[i]
 		k := i
-		for k > 0 && v < a[k-1] {
+		for k > 0 && cmp(v, a[k-1]) < 0 {
 			a[k] = a[k-1]
 			k--
 		}
@@ -28,12 +47,13 @@ func InsertionSortSwapOnce(a []int) {
 	}
 }
 
-// InsertionSortShift sorts an int slice in ascending order by shifting values.
-func InsertionSortShift(a []int) {
+// InsertionSortShiftFunc is a generic variant of InsertionSortShift that sorts
+// a in ascending order as determined by cmp.
+func InsertionSortShiftFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
 	for i := 1; i < len(a); i++ {
 		k := i
 		temp := a[i]
-		for k > 0 && a[i] < a[k-1] {
+		for k > 0 && cmp(a[i], a[k-1]) < 0 {
 			k--
 		}
 		for m := i; m > k; m-- {
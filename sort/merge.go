@@ -1,16 +1,58 @@
 package goalgorithms
 
-func mergeTopDown(a []int, b []int, i, size int) {
+import "cmp"
+
+// MergeSortTopDown performs in-place sort of int slice in ascending order.
+func MergeSortTopDown(a []int) {
+	MergeSortTopDownFunc(a, cmp.Compare[int])
+}
+
+// MergeSortTopDown2 performs in-place sort of int slice in ascending order.
+func MergeSortTopDown2(a []int) {
+	MergeSortTopDown2Func(a, cmp.Compare[int])
+}
+
+// MergeSortTopDown3 performs in-place sort of int slice in ascending order.
+func MergeSortTopDown3(a []int) {
+	MergeSortTopDown3Func(a, cmp.Compare[int])
+}
+
+// MergeSortBottomUp1 performs in-place sort of int slice in ascending order.
+func MergeSortBottomUp1(a []int) {
+	MergeSortBottomUp1Func(a, cmp.Compare[int])
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// MergeSortBottomUp2 performs in-place sort of int slice in ascending order.
+func MergeSortBottomUp2(a []int) {
+	MergeSortBottomUp2Func(a, cmp.Compare[int])
+}
+
+// mergeTakesLeft is the step of the merge loops below. Given two sorted runs x
+// and y, of which x[:l] and y[:r] are already merged, it reports whether the
+// next element of the merge is x[l]. Equal elements are taken from x first,
+// which keeps the merge stable.
+func mergeTakesLeft[E any](x []E, l int, y []E, r int, cmp func(a, b E) int) bool {
+	return l < len(x) && (r >= len(y) || cmp(x[l], y[r]) <= 0)
+}
+
+func mergeTopDownFunc[E any](a []E, b []E, i, size int, cmp func(a, b E) int) {
 	l := i
 	lsize := size/2 + size%2
 	r := i + lsize
 	rsize := size - lsize
 
 	if lsize > 1 {
-		mergeTopDown(a, b, l, lsize)
+		mergeTopDownFunc(a, b, l, lsize, cmp)
 	}
 	if rsize > 1 {
-		mergeTopDown(a, b, r, rsize)
+		mergeTopDownFunc(a, b, r, rsize, cmp)
 	}
 
 	lmax := l + lsize
@@ -24,7 +66,7 @@ func mergeTopDown(a []int, b []int, i, size int) {
 		} else if r == rmax {
 			b[z] = a[l]
 			l++
-		} else if a[l] <= a[r] {
+		} else if cmp(a[l], a[r]) <= 0 {
 			b[z] = a[l]
 			l++
 		} else {
@@ -39,20 +81,21 @@ func mergeTopDown(a []int, b []int, i, size int) {
 	}
 }
 
-// MergeSortTopDown performs in-place sort of int slice in ascending order.
-func MergeSortTopDown(a []int) {
-	b := make([]int, len(a), len(a))
-	mergeTopDown(a, b, 0, len(a))
+// MergeSortTopDownFunc is a generic variant of MergeSortTopDown that sorts a
+// in ascending order as determined by cmp.
+func MergeSortTopDownFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	b := make([]E, len(a), len(a))
+	mergeTopDownFunc(a, b, 0, len(a), cmp)
 }
 
-func mergeTopDown2(a []int, b []int, left, right int) {
+func mergeTopDown2Func[E any](a []E, b []E, left, right int, cmp func(a, b E) int) {
 	middle := left + ((right - left) / 2)
 
 	if middle-left > 1 {
-		mergeTopDown2(a, b, left, middle)
+		mergeTopDown2Func(a, b, left, middle, cmp)
 	}
 	if right-middle > 1 {
-		mergeTopDown2(a, b, middle, right)
+		mergeTopDown2Func(a, b, middle, right, cmp)
 	}
 
 	s := right - left
@@ -66,7 +109,7 @@ func mergeTopDown2(a []int, b []int, left, right int) {
 		} else if r == right {
 			b[z] = a[l]
 			l++
-		} else if a[l] <= a[r] {
+		} else if cmp(a[l], a[r]) <= 0 {
 			b[z] = a[l]
 			l++
 		} else {
@@ -81,26 +124,27 @@ func mergeTopDown2(a []int, b []int, left, right int) {
 	}
 }
 
-// MergeSortTopDown2 performs in-place sort of int slice in ascending order.
-func MergeSortTopDown2(a []int) {
-	b := make([]int, len(a), len(a))
-	mergeTopDown2(a, b, 0, len(a))
+// MergeSortTopDown2Func is a generic variant of MergeSortTopDown2 that sorts a
+// in ascending order as determined by cmp.
+func MergeSortTopDown2Func[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	b := make([]E, len(a), len(a))
+	mergeTopDown2Func(a, b, 0, len(a), cmp)
 }
 
-func mergeTopDown3(a []int, b []int, left, right int) {
+func mergeTopDown3Func[E any](a []E, b []E, left, right int, cmp func(a, b E) int) {
 	middle := left + ((right - left) / 2)
 
 	if middle-left > 1 {
-		mergeTopDown3(a, b, left, middle)
+		mergeTopDown3Func(a, b, left, middle, cmp)
 	}
 	if right-middle > 1 {
-		mergeTopDown3(a, b, middle, right)
+		mergeTopDown3Func(a, b, middle, right, cmp)
 	}
 
 	l := left
 	r := middle
 	for z := left; z < right; z++ {
-		if l < middle && (r == right || a[l] <= a[r]) {
+		if mergeTakesLeft(a[:middle], l, a[:right], r, cmp) {
 			b[z] = a[l]
 			l++
 		} else {
@@ -114,15 +158,17 @@ func mergeTopDown3(a []int, b []int, left, right int) {
 	}
 }
 
-// MergeSortTopDown3 performs in-place sort of int slice in ascending order.
-func MergeSortTopDown3(a []int) {
-	b := make([]int, len(a), len(a))
-	mergeTopDown3(a, b, 0, len(a))
+// MergeSortTopDown3Func is a generic variant of MergeSortTopDown3 that sorts a
+// in ascending order as determined by cmp.
+func MergeSortTopDown3Func[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	b := make([]E, len(a), len(a))
+	mergeTopDown3Func(a, b, 0, len(a), cmp)
 }
 
-// MergeSortBottomUp1 performs in-place sort of int slice in ascending order.
-func MergeSortBottomUp1(a []int) {
-	b := make([]int, len(a), len(a))
+// MergeSortBottomUp1Func is a generic variant of MergeSortBottomUp1 that sorts
+// a in ascending order as determined by cmp.
+func MergeSortBottomUp1Func[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	b := make([]E, len(a), len(a))
 	s := 1
 	for s < len(a) {
 		for left, right := 0, s; left < len(a); left, right = left+s*2, right+s*2 {
@@ -138,7 +184,7 @@ func MergeSortBottomUp1(a []int) {
 				rs = len(a)
 			}
 			for l < ls || r < rs {
-				if l < ls && (r >= rs || a[l] <= a[r]) {
+				if mergeTakesLeft(a[:ls], l, a[:rs], r, cmp) {
 					b[z] = a[l]
 					l++
 				} else {
@@ -155,24 +201,28 @@ func MergeSortBottomUp1(a []int) {
 	}
 }
 
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
+// MergeSortBottomUp2Func is a generic variant of MergeSortBottomUp2 that sorts
+// a in ascending order as determined by cmp.
+func MergeSortBottomUp2Func[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	mergeSortBottomUp2Func(nil, a, cmp)
 }
 
-// MergeSortBottomUp2 performs in-place sort of int slice in ascending order.
-func MergeSortBottomUp2(a []int) {
-	b := make([]int, len(a), len(a))
-	for s := 1; s < len(a); s *= 2 {
-		for left := 0; left < len(a); left += s * 2 {
+// mergeSortBottomUp2Func is the body of MergeSortBottomUp2Func and
+// MergeSortBottomUpContext. s, which may be nil, is checked before each merge
+// of two runs and advanced by the number of elements merged.
+func mergeSortBottomUp2Func[E any](s *ctxSorter, a []E, cmp func(a, b E) int) error {
+	b := make([]E, len(a), len(a))
+	for size := 1; size < len(a); size *= 2 {
+		for left := 0; left < len(a); left += size * 2 {
+			if err := s.check(); err != nil {
+				return err
+			}
 			l := left
-			r := left + s
+			r := left + size
 			ls := min(r, len(a))
-			rs := min(r+s, len(a))
+			rs := min(r+size, len(a))
 			for z := left; z < rs; z++ {
-				if l < ls && (r >= rs || a[l] <= a[r]) {
+				if mergeTakesLeft(a[:ls], l, a[:rs], r, cmp) {
 					b[z] = a[l]
 					l++
 				} else {
@@ -184,6 +234,8 @@ func MergeSortBottomUp2(a []int) {
 			for z := left; z < rs; z++ {
 				a[z] = b[z]
 			}
+			s.advance(rs - left)
 		}
 	}
+	return nil
 }
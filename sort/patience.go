@@ -0,0 +1,134 @@
+package goalgorithms
+
+import "cmp"
+
+// dealPiles deals the elements of a, in order, onto piles like in the card
+// game of patience, and returns the piles as slices of indices into a, bottom
+// first. Each element goes onto the leftmost pile whose top it fits on, or
+// onto a new pile on the right. fits must be false for the tops of a prefix
+// of the piles and true for the rest, so that piles can be binary searched.
+// If prev is not nil, prev[i] is set to the index of the top of the pile to
+// the left of the one that a[i] went onto, or -1 for the first pile.
+func dealPiles[E any](a []E, fits func(top, v E) bool, prev []int) [][]int {
+	var piles [][]int
+	for i, v := range a {
+		lo, hi := 0, len(piles)
+		for lo < hi {
+			m := int(uint(lo+hi) >> 1)
+			if fits(a[piles[m][len(piles[m])-1]], v) {
+				hi = m
+			} else {
+				lo = m + 1
+			}
+		}
+		if lo == len(piles) {
+			piles = append(piles, nil)
+		}
+		piles[lo] = append(piles[lo], i)
+		if prev != nil {
+			prev[i] = -1
+			if lo > 0 {
+				prev[i] = piles[lo-1][len(piles[lo-1])-1]
+			}
+		}
+	}
+	return piles
+}
+
+// PatienceSort performs a stable sort of int slice in ascending order using
+// patience sort. Elements are dealt onto piles, each going on top of the
+// leftmost pile whose top is not greater than it, so every pile is sorted and
+// the tops decrease from left to right. The piles are then merged with a heap.
+// The number of piles is the length of the longest strictly decreasing
+// subsequence of a, so sorted input ends up on a single pile.
+// Best time compexity: O(n), on already sorted input
+// Worst case time compexity: O(n log(n))
+// Worst case space compexity: O(n)
+func PatienceSort(a []int) {
+	PatienceSortFunc(a, cmp.Compare[int])
+}
+
+// PatienceSortFunc is a generic variant of PatienceSort that sorts a in
+// ascending order as determined by cmp.
+func PatienceSortFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	if len(a) < 2 {
+		return
+	}
+	src := make([]E, len(a))
+	copy(src, a)
+	piles := dealPiles(src, func(top, v E) bool { return cmp(top, v) <= 0 }, nil)
+
+	// An element equal to an earlier one goes onto the same pile or a pile
+	// to the right of it, so breaking ties by pile keeps the sort stable.
+	pos := make([]int, len(piles))
+	heap := make([]int, len(piles))
+	for k := range heap {
+		heap[k] = k
+	}
+	less := func(i, j int) bool {
+		c := cmp(src[piles[i][pos[i]]], src[piles[j][pos[j]]])
+		return c < 0 || c == 0 && i < j
+	}
+	down := func(i int) {
+		for {
+			m := i
+			if l := 2*i + 1; l < len(heap) && less(heap[l], heap[m]) {
+				m = l
+			}
+			if r := 2*i + 2; r < len(heap) && less(heap[r], heap[m]) {
+				m = r
+			}
+			if m == i {
+				return
+			}
+			heap[i], heap[m] = heap[m], heap[i]
+			i = m
+		}
+	}
+	for i := len(heap)/2 - 1; i >= 0; i-- {
+		down(i)
+	}
+
+	for z := range a {
+		k := heap[0]
+		a[z] = src[piles[k][pos[k]]]
+		pos[k]++
+		if pos[k] == len(piles[k]) {
+			heap[0] = heap[len(heap)-1]
+			heap = heap[:len(heap)-1]
+		}
+		down(0)
+	}
+}
+
+// LongestIncreasingSubsequence returns the indices of a longest strictly
+// increasing subsequence of a, in ascending order. It is found by dealing the
+// elements onto piles like PatienceSort does, but with the order reversed,
+// remembering for each element the top of the pile to its left at the time it
+// was dealt.
+// Worst case time compexity: O(n log(n))
+// Worst case space compexity: O(n)
+func LongestIncreasingSubsequence(a []int) []int {
+	return LongestIncreasingSubsequenceFunc(a, cmp.Compare[int])
+}
+
+// LongestIncreasingSubsequenceFunc is a generic variant of
+// LongestIncreasingSubsequence that compares elements with cmp.
+func LongestIncreasingSubsequenceFunc[S ~[]E, E any](a S, cmp func(a, b E) int) []int {
+	if len(a) == 0 {
+		return nil
+	}
+	// Elements go onto the leftmost pile whose top is not smaller, so the
+	// tops strictly increase from left to right, and every element is greater
+	// than the top of the pile to its left. The number of piles is the length
+	// of the subsequence.
+	prev := make([]int, len(a))
+	piles := dealPiles(a, func(top, v E) bool { return cmp(top, v) >= 0 }, prev)
+	lis := make([]int, len(piles))
+	i := piles[len(piles)-1][len(piles[len(piles)-1])-1]
+	for k := len(lis) - 1; k >= 0; k-- {
+		lis[k] = i
+		i = prev[i]
+	}
+	return lis
+}
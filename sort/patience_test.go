@@ -0,0 +1,65 @@
+package goalgorithms
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// lisLength returns the length of the longest strictly increasing
+// subsequence of a with the quadratic dynamic programming solution.
+func lisLength(a []int) int {
+	best := 0
+	length := make([]int, len(a))
+	for i := range a {
+		length[i] = 1
+		for j := 0; j < i; j++ {
+			if a[j] < a[i] && length[j]+1 > length[i] {
+				length[i] = length[j] + 1
+			}
+		}
+		best = max(best, length[i])
+	}
+	return best
+}
+
+func TestLongestIncreasingSubsequence(t *testing.T) {
+	tests := []struct {
+		name string
+		list []int
+		want int
+	}{
+		{"Nil", nil, 0},
+		{"Single", []int{5}, 1},
+		{"Ascending", []int{1, 2, 3, 4, 5}, 5},
+		{"Descending", []int{5, 4, 3, 2, 1}, 1},
+		{"Equal", []int{2, 2, 2, 2}, 1},
+		{"Mixed", []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5}, 4},
+		{"Classic", []int{0, 8, 4, 12, 2, 10, 6, 14, 1, 9, 5, 13, 3, 11, 7, 15}, 6},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		list := make([]int, rnd.Intn(200))
+		for j := range list {
+			list[j] = rnd.Intn(50)
+		}
+		tests = append(tests, struct {
+			name string
+			list []int
+			want int
+		}{"Random", list, lisLength(list)})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LongestIncreasingSubsequence(tt.list)
+			if len(got) != tt.want {
+				t.Fatalf("LongestIncreasingSubsequence(%v) = %v, want length %d", tt.list, got, tt.want)
+			}
+			for k := 1; k < len(got); k++ {
+				if got[k] <= got[k-1] || tt.list[got[k]] <= tt.list[got[k-1]] {
+					t.Fatalf("LongestIncreasingSubsequence(%v) = %v, which is not increasing at %d", tt.list, got, k)
+				}
+			}
+		})
+	}
+}
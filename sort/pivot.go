@@ -0,0 +1,125 @@
+package goalgorithms
+
+import (
+	"context"
+	"math/rand"
+)
+
+// PivotStrategy chooses the pivot for partitioning a[left:right], ordered by
+// cmp, and returns its index, which must be in the range [left, right).
+type PivotStrategy[E any] func(a []E, left, right int, cmp func(a, b E) int) int
+
+// PivotFirst chooses the first element. Sorted and reversed input make
+// quicksort quadratic with this strategy.
+func PivotFirst[E any](a []E, left, right int, cmp func(a, b E) int) int {
+	return left
+}
+
+// PivotLast chooses the last element, as QuickSortLomuto does.
+func PivotLast[E any](a []E, left, right int, cmp func(a, b E) int) int {
+	return right - 1
+}
+
+// PivotMiddle chooses the middle element, as QuickSortHoare does.
+func PivotMiddle[E any](a []E, left, right int, cmp func(a, b E) int) int {
+	return left + (right-left)/2
+}
+
+// PivotMedianOfThree chooses the median of the first, middle and last
+// elements, as QuickSortHoareM3 does.
+func PivotMedianOfThree[E any](a []E, left, right int, cmp func(a, b E) int) int {
+	return medianIndexFunc(a, left, left+(right-left)/2, right-1, cmp)
+}
+
+// nintherMin is the smallest range for which PivotNinther samples nine
+// elements. Smaller ranges use the median of three.
+const nintherMin = 40
+
+// PivotNinther chooses Tukey's ninther: the median of the medians of three
+// groups of three elements spread evenly over the range. It gives a better
+// estimate of the true median than the median of three on large ranges.
+func PivotNinther[E any](a []E, left, right int, cmp func(a, b E) int) int {
+	n := right - left
+	if n < nintherMin {
+		return PivotMedianOfThree(a, left, right, cmp)
+	}
+	step := n / 8
+	middle := left + n/2
+	last := right - 1
+	return medianIndexFunc(a,
+		medianIndexFunc(a, left, left+step, left+2*step, cmp),
+		medianIndexFunc(a, middle-step, middle, middle+step, cmp),
+		medianIndexFunc(a, last-2*step, last-step, last, cmp), cmp)
+}
+
+// PivotRandom returns a strategy that chooses a uniformly random element using
+// rnd, so that results can be reproduced by seeding rnd. If rnd is nil, the
+// shared source of math/rand is used.
+func PivotRandom[E any](rnd *rand.Rand) PivotStrategy[E] {
+	intn := rand.Intn
+	if rnd != nil {
+		intn = rnd.Intn
+	}
+	return func(a []E, left, right int, cmp func(a, b E) int) int {
+		return left + intn(right-left)
+	}
+}
+
+// PartitionScheme selects how QuickSorter partitions around the pivot.
+type PartitionScheme int
+
+const (
+	// PartitionHoare scans from both ends and swaps pairs of misplaced elements.
+	PartitionHoare PartitionScheme = iota
+	// PartitionLomuto swaps the pivot to the end and scans from the left only.
+	PartitionLomuto
+)
+
+// QuickSorter is a quicksort with a configurable partition scheme and pivot
+// strategy. QuickSortHoare, QuickSortHoareM3, QuickSortLomuto and their Func
+// and Context variants are all QuickSorters with a fixed configuration.
+type QuickSorter[E any] struct {
+	Partition PartitionScheme
+	// Pivot chooses the pivot of every partition step. If nil, PivotMiddle
+	// is used with Hoare partitioning and PivotLast with Lomuto partitioning.
+	Pivot PivotStrategy[E]
+	// Cmp orders the elements, e.g. cmp.Compare[int].
+	Cmp func(a, b E) int
+}
+
+// Sort performs in-place sort of a in ascending order as determined by q.Cmp.
+// Worst case time compexity: O(n^2), how likely it is depends on the strategy.
+// Average time compexity: O(n log(n))
+// Worst case space compexity: O(n)
+func (q QuickSorter[E]) Sort(a []E) {
+	q.sort(nil, a)
+}
+
+// SortContext is a variant of Sort that can be interrupted. See
+// QuickSortHoareContext for the meaning of ctx and progress.
+func (q QuickSorter[E]) SortContext(ctx context.Context, a []E, progress ProgressFunc) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s := newCtxSorter(ctx, len(a), progress)
+	if err := q.sort(s, a); err != nil {
+		return err
+	}
+	s.finish()
+	return nil
+}
+
+func (q QuickSorter[E]) sort(s *ctxSorter, a []E) error {
+	if q.Partition == PartitionLomuto {
+		pivot := q.Pivot
+		if pivot == nil {
+			pivot = PivotLast[E]
+		}
+		return quickSortLomutoFunc(s, a, 0, len(a), q.Cmp, pivot)
+	}
+	pivot := q.Pivot
+	if pivot == nil {
+		pivot = PivotMiddle[E]
+	}
+	return quickSortHoareFunc(s, a, 0, len(a), q.Cmp, pivot)
+}
@@ -0,0 +1,152 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+var pivotStrategies = []struct {
+	name  string
+	pivot PivotStrategy[int]
+}{
+	{"First", PivotFirst[int]},
+	{"Last", PivotLast[int]},
+	{"Middle", PivotMiddle[int]},
+	{"MedianOfThree", PivotMedianOfThree[int]},
+	{"Ninther", PivotNinther[int]},
+	{"Random", PivotRandom[int](rand.New(rand.NewSource(1)))},
+}
+
+func TestPivotStrategy(t *testing.T) {
+	tests := []struct {
+		name        string
+		list        []int
+		left, right int
+		want        map[string]int
+	}{
+		{"Single element", []int{7}, 0, 1, map[string]int{"First": 0, "Last": 0, "Middle": 0, "MedianOfThree": 0, "Ninther": 0}},
+		{"Median at end", []int{1, 9, 5}, 0, 3, map[string]int{"First": 0, "Last": 2, "Middle": 1, "MedianOfThree": 2, "Ninther": 2}},
+		{"Median in range", []int{9, 0, 5, 6, 1}, 1, 4, map[string]int{"First": 1, "Last": 3, "Middle": 2, "MedianOfThree": 2, "Ninther": 2}},
+		{"All equal", []int{4, 4, 4, 4}, 0, 4, map[string]int{"First": 0, "Last": 3, "Middle": 2}},
+	}
+	for _, tt := range tests {
+		for _, s := range pivotStrategies {
+			t.Run(tt.name+"/"+s.name, func(t *testing.T) {
+				got := s.pivot(tt.list, tt.left, tt.right, cmp.Compare[int])
+				if got < tt.left || got >= tt.right {
+					t.Fatalf("Pivot%s(%v, %d, %d) = %d, out of range", s.name, tt.list, tt.left, tt.right, got)
+				}
+				if want, ok := tt.want[s.name]; ok && got != want {
+					t.Errorf("Pivot%s(%v, %d, %d) = %d, want %d", s.name, tt.list, tt.left, tt.right, got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestPivotNinther(t *testing.T) {
+	// On random input, the ninther should miss the middle half of the values
+	// less often than the median of three.
+	rnd := rand.New(rand.NewSource(1))
+	n := 1001
+	list := rnd.Perm(n)
+	far := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		rnd.Shuffle(n, func(i, j int) { list[i], list[j] = list[j], list[i] })
+		for name, pivot := range map[string]PivotStrategy[int]{"Ninther": PivotNinther[int], "MedianOfThree": PivotMedianOfThree[int]} {
+			if v := list[pivot(list, 0, n, cmp.Compare[int])]; v < n/4 || v > 3*n/4 {
+				far[name]++
+			}
+		}
+	}
+	if far["Ninther"] >= far["MedianOfThree"] {
+		t.Errorf("PivotNinther() was outside the middle half %d times, PivotMedianOfThree() %d times", far["Ninther"], far["MedianOfThree"])
+	}
+}
+
+func TestPivotRandom_Seeded(t *testing.T) {
+	list := make([]int, 100)
+	a := PivotRandom[int](rand.New(rand.NewSource(42)))
+	b := PivotRandom[int](rand.New(rand.NewSource(42)))
+	for i := 0; i < 20; i++ {
+		if pa, pb := a(list, 10, 90, cmp.Compare[int]), b(list, 10, 90, cmp.Compare[int]); pa != pb {
+			t.Fatalf("PivotRandom() with the same seed chose %d and %d", pa, pb)
+		}
+	}
+	if p := PivotRandom[int](nil)(list, 5, 6, cmp.Compare[int]); p != 5 {
+		t.Errorf("PivotRandom(nil)(list, 5, 6) = %d, want 5", p)
+	}
+}
+
+func TestQuickSorter(t *testing.T) {
+	want := []string{"apple", "banana", "cherry", "date", "elderberry", "fig", "grape"}
+	for _, partition := range []PartitionScheme{PartitionHoare, PartitionLomuto} {
+		for _, pivot := range []PivotStrategy[string]{nil, PivotFirst[string], PivotNinther[string], PivotRandom[string](nil)} {
+			q := QuickSorter[string]{Partition: partition, Pivot: pivot, Cmp: cmp.Compare[string]}
+			got := []string{"fig", "cherry", "apple", "grape", "banana", "elderberry", "date"}
+			q.Sort(got)
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("QuickSorter{%d}.Sort() = %v, want %v", partition, got, want)
+			}
+
+			got = []string{"fig", "cherry", "apple", "grape", "banana", "elderberry", "date"}
+			calls := 0
+			if err := q.SortContext(context.Background(), got, func(float64) { calls++ }); err != nil {
+				t.Fatalf("QuickSorter{%d}.SortContext() = %v", partition, err)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(want) || calls == 0 {
+				t.Errorf("QuickSorter{%d}.SortContext() sorted %v with %d progress calls, want %v", partition, got, calls, want)
+			}
+		}
+	}
+}
+
+// organPipe returns 0, 1, ..., n/2, ..., 1, 0.
+func organPipe(n int) []int {
+	list := make([]int, n)
+	for i := range list {
+		list[i] = min(i, n-1-i)
+	}
+	return list
+}
+
+func BenchmarkPivot(b *testing.B) {
+	const n = 10000
+	inputs := []struct {
+		name string
+		list []int
+	}{
+		{"random", rand.New(rand.NewSource(1)).Perm(n)},
+		{"sorted", make([]int, n)},
+		{"reversed", make([]int, n)},
+		{"organpipe", organPipe(n)},
+	}
+	for i := 0; i < n; i++ {
+		inputs[1].list[i] = i
+		inputs[2].list[i] = n - i
+	}
+	sorts := []struct {
+		name      string
+		partition PartitionScheme
+	}{
+		{"Hoare", PartitionHoare},
+		{"Lomuto", PartitionLomuto},
+	}
+
+	tosort := make([]int, n)
+	for _, in := range inputs {
+		for _, s := range sorts {
+			for _, p := range pivotStrategies {
+				b.Run(fmt.Sprintf("%s_%s_%s", s.name, p.name, in.name), func(b *testing.B) {
+					for i := 0; i < b.N; i++ {
+						copy(tosort, in.list)
+						QuickSorter[int]{Partition: s.partition, Pivot: p.pivot, Cmp: cmp.Compare[int]}.Sort(tosort)
+					}
+				})
+			}
+		}
+	}
+}
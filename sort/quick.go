@@ -1,41 +1,13 @@
 package goalgorithms
 
-func hoarePartition(a []int, left, right int) int {
-	p := a[left+(right-left)/2]
-	i := left
-	j := right - 1
-	for {
-		for a[i] < p {
-			i++
-		}
-
-		for a[j] > p {
-			j--
-		}
-
-		if i >= j {
-			return j
-		}
-
-		a[i], a[j] = a[j], a[i]
-	}
-}
-
-func quickSortHoare(a []int, left, right int) {
-	if right-left < 2 {
-		return
-	}
-	p := hoarePartition(a, left, right)
-	quickSortHoare(a, left, p)
-	quickSortHoare(a, p+1, right)
-}
+import "cmp"
 
 // QuickSortHoare performs in-place sort of int slice in ascending order using Hoare partitioning.
 // Worst case time compexity: O(n^2)
 // Average time compexity: O(n log(n))
 // Worst case space compexity: O(n)
 func QuickSortHoare(a []int) {
-	quickSortHoare(a, 0, len(a))
+	QuickSortHoareFunc(a, cmp.Compare[int])
 }
 
 func max(a, b int) int {
@@ -45,26 +17,43 @@ func max(a, b int) int {
 	return a
 }
 
-func medianOfThree(a []int, v1, v2, v3 int) int {
-	if v1 > v2 && v1 < v3 || v1 > v3 && v1 < v2 {
-		return v1
-	} else if v2 > v1 && v2 < v3 || v2 > v3 && v2 < v1 {
-		return v2
-	} else {
-		return v3
-	}
+// QuickSortHoareM3 performs in-place sort of int slice in ascending order using Hoare
+// partitioning and median of three for pivot selection.
+// Worst case time compexity is still O(n^2), but not so for already sorted arrays.
+// Average time compexity: O(n log(n)).
+// Worst case space compexity: O(n).
+func QuickSortHoareM3(a []int) {
+	QuickSortHoareM3Func(a, cmp.Compare[int])
 }
 
-func hoarePartitionM3(a []int, left, right int) int {
-	p := medianOfThree(a, a[left], a[left+(right-left)/2], a[right-1])
-	i := left
-	j := right - 1
+// QuickSortLomuto performs in-place sort of int slice in ascending order using Lomuto partitioning.
+// Worst case time compexity: O(n^2)
+// Average time compexity: O(n log(n))
+// Worst case space compexity: O(n)
+func QuickSortLomuto(a []int) {
+	QuickSortLomutoFunc(a, cmp.Compare[int])
+}
+
+// hoarePartitionFunc partitions a[left:right] around the value of a[pivot]
+// using Hoare's scheme and returns an index p, such that no element of
+// a[left:p+1] is greater than the pivot and no element of a[p+1:right] is
+// smaller.
+// The pivot is first moved to the left end, which guarantees that both parts
+// are non-empty. Scans stop on elements equal to the pivot and both advance
+// after every swap, so runs of equal values are split evenly.
+func hoarePartitionFunc[E any](a []E, left, right, pivot int, cmp func(a, b E) int) int {
+	a[left], a[pivot] = a[pivot], a[left]
+	p := a[left]
+	i := left - 1
+	j := right
 	for {
-		for a[i] < p {
+		i++
+		for cmp(a[i], p) < 0 {
 			i++
 		}
 
-		for a[j] > p {
+		j--
+		for cmp(a[j], p) > 0 {
 			j--
 		}
 
@@ -76,29 +65,58 @@ func hoarePartitionM3(a []int, left, right int) int {
 	}
 }
 
-func quickSortHoareM3(a []int, left, right int) {
+func quickSortHoareFunc[E any](s *ctxSorter, a []E, left, right int, cmp func(a, b E) int, pivot PivotStrategy[E]) error {
 	if right-left < 2 {
-		return
+		s.advance(right - left)
+		return nil
+	}
+	if err := s.check(); err != nil {
+		return err
 	}
-	p := hoarePartitionM3(a, left, right)
-	quickSortHoareM3(a, left, p)
-	quickSortHoareM3(a, p+1, right)
+	p := hoarePartitionFunc(a, left, right, pivot(a, left, right, cmp), cmp)
+	if err := quickSortHoareFunc(s, a, left, p+1, cmp, pivot); err != nil {
+		return err
+	}
+	return quickSortHoareFunc(s, a, p+1, right, cmp, pivot)
 }
 
-// QuickSortHoareM3 performs in-place sort of int slice in ascending order using Hoare
-// partitioning and median of three for pivot selection.
-// Worst case time compexity is still O(n^2), but not so for already sorted arrays.
-// Average time compexity: O(n log(n)).
-// Worst case space compexity: O(n).
-func QuickSortHoareM3(a []int) {
-	quickSortHoareM3(a, 0, len(a))
+// QuickSortHoareFunc is a generic variant of QuickSortHoare that sorts a in
+// ascending order as determined by cmp.
+func QuickSortHoareFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	QuickSorter[E]{Partition: PartitionHoare, Pivot: PivotMiddle[E], Cmp: cmp}.Sort(a)
 }
 
-func lomutoPartition(a []int, left, right int) int {
+// medianIndexFunc returns the index of the median of a[i], a[j] and a[k].
+func medianIndexFunc[E any](a []E, i, j, k int, cmp func(a, b E) int) int {
+	if cmp(a[i], a[j]) < 0 {
+		if cmp(a[j], a[k]) < 0 {
+			return j
+		} else if cmp(a[i], a[k]) < 0 {
+			return k
+		}
+		return i
+	}
+	if cmp(a[i], a[k]) < 0 {
+		return i
+	} else if cmp(a[j], a[k]) < 0 {
+		return k
+	}
+	return j
+}
+
+// QuickSortHoareM3Func is a generic variant of QuickSortHoareM3 that sorts a
+// in ascending order as determined by cmp.
+func QuickSortHoareM3Func[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	QuickSorter[E]{Partition: PartitionHoare, Pivot: PivotMedianOfThree[E], Cmp: cmp}.Sort(a)
+}
+
+// lomutoPartitionFunc partitions a[left:right] around its last element using
+// Lomuto's scheme and returns the final index of the pivot.
+func lomutoPartitionFunc[E any](a []E, left, right int, cmp func(a, b E) int) int {
 	p := a[right-1]
 	i := left
 	for j := left; j < right-1; j++ {
-		if a[j] < p {
+		if cmp(a[j], p) < 0 {
 			a[i], a[j] = a[j], a[i]
 			i++
 		}
@@ -107,19 +125,27 @@ func lomutoPartition(a []int, left, right int) int {
 	return i
 }
 
-func quickSortLomuto(a []int, left, right int) {
+func quickSortLomutoFunc[E any](s *ctxSorter, a []E, left, right int, cmp func(a, b E) int, pivot PivotStrategy[E]) error {
 	if right-left < 2 {
-		return
+		s.advance(right - left)
+		return nil
+	}
+	if err := s.check(); err != nil {
+		return err
 	}
-	p := lomutoPartition(a, left, right)
-	quickSortLomuto(a, left, p)
-	quickSortLomuto(a, p+1, right)
+	i := pivot(a, left, right, cmp)
+	a[i], a[right-1] = a[right-1], a[i]
+	p := lomutoPartitionFunc(a, left, right, cmp)
+	// The pivot is in its final position.
+	s.advance(1)
+	if err := quickSortLomutoFunc(s, a, left, p, cmp, pivot); err != nil {
+		return err
+	}
+	return quickSortLomutoFunc(s, a, p+1, right, cmp, pivot)
 }
 
-// QuickSortLomuto performs in-place sort of int slice in ascending order using Lomuto partitioning.
-// Worst case time compexity: O(n^2)
-// Average time compexity: O(n log(n))
-// Worst case space compexity: O(n)
-func QuickSortLomuto(a []int) {
-	quickSortLomuto(a, 0, len(a))
+// QuickSortLomutoFunc is a generic variant of QuickSortLomuto that sorts a in
+// ascending order as determined by cmp.
+func QuickSortLomutoFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	QuickSorter[E]{Partition: PartitionLomuto, Pivot: PivotLast[E], Cmp: cmp}.Sort(a)
 }
@@ -0,0 +1,71 @@
+package goalgorithms
+
+import "strings"
+
+// Algorithm describes one of the sorting algorithms in this package.
+type Algorithm struct {
+	// Name is the name of the int sorting function, e.g. "QuickSortHoare".
+	Name string
+	// Sort sorts an int slice in-place in ascending order.
+	Sort func([]int)
+	// SortFunc is the generic variant of the algorithm instantiated for int.
+	// It can be passed to SortInterface or used to sort indices of elements
+	// of any type by a cmp function that compares the elements.
+	// It is nil for distribution sorts, which need the values themselves.
+	SortFunc func(a []int, cmp func(a, b int) int)
+	// Best, Average and Worst are the time complexity classes.
+	Best, Average, Worst string
+	// Space is the worst case extra space used by the algorithm.
+	Space string
+	// Stable is true if equal elements keep their original order.
+	Stable bool
+	// InPlace is true if the algorithm does not need a buffer proportional
+	// to the size of the input. The recursion stack is not counted.
+	InPlace bool
+}
+
+var algorithms = []Algorithm{
+	{"InsertionSortSwap", InsertionSortSwap, InsertionSortSwapFunc, "O(n)", "O(n^2)", "O(n^2)", "O(1)", true, true},
+	{"InsertionSortSwapOnce", InsertionSortSwapOnce, InsertionSortSwapOnceFunc, "O(n)", "O(n^2)", "O(n^2)", "O(1)", true, true},
+	{"InsertionSortShift", InsertionSortShift, InsertionSortShiftFunc, "O(n)", "O(n^2)", "O(n^2)", "O(1)", true, true},
+	{"SelectionSort", SelectionSort, SelectionSortFunc, "O(n^2)", "O(n^2)", "O(n^2)", "O(1)", false, true},
+	{"SelectionSortTemp", SelectionSortTemp, SelectionSortTempFunc, "O(n^2)", "O(n^2)", "O(n^2)", "O(1)", false, true},
+	{"SelectionSortMinSwap", SelectionSortMinSwap, SelectionSortMinSwapFunc, "O(n^2)", "O(n^2)", "O(n^2)", "O(1)", false, true},
+	{"CycleSort", CycleSort, CycleSortFunc, "O(n^2)", "O(n^2)", "O(n^2)", "O(1)", false, true},
+	{"SmoothSort", SmoothSort, SmoothSortFunc, "O(n)", "O(n log(n))", "O(n log(n))", "O(1)", false, true},
+	{"BubbleSort", BubbleSort, BubbleSortFunc, "O(n^2)", "O(n^2)", "O(n^2)", "O(1)", true, true},
+	{"BubbleSortTwoLoops", BubbleSortTwoLoops, BubbleSortTwoLoopsFunc, "O(n^2)", "O(n^2)", "O(n^2)", "O(1)", true, true},
+	{"MergeSortTopDown", MergeSortTopDown, MergeSortTopDownFunc, "O(n log(n))", "O(n log(n))", "O(n log(n))", "O(n)", true, false},
+	{"MergeSortTopDown2", MergeSortTopDown2, MergeSortTopDown2Func, "O(n log(n))", "O(n log(n))", "O(n log(n))", "O(n)", true, false},
+	{"MergeSortTopDown3", MergeSortTopDown3, MergeSortTopDown3Func, "O(n log(n))", "O(n log(n))", "O(n log(n))", "O(n)", true, false},
+	{"MergeSortBottomUp1", MergeSortBottomUp1, MergeSortBottomUp1Func, "O(n log(n))", "O(n log(n))", "O(n log(n))", "O(n)", true, false},
+	{"MergeSortBottomUp2", MergeSortBottomUp2, MergeSortBottomUp2Func, "O(n log(n))", "O(n log(n))", "O(n log(n))", "O(n)", true, false},
+	{"MergeSortTiled", MergeSortTiled, MergeSortTiledFunc, "O(n log(n))", "O(n log(n))", "O(n log(n))", "O(n)", true, false},
+	{"FunnelSort", FunnelSort, FunnelSortFunc, "O(n log(n))", "O(n log(n))", "O(n log(n))", "O(n)", true, false},
+	{"PatienceSort", PatienceSort, PatienceSortFunc, "O(n)", "O(n log(n))", "O(n log(n))", "O(n)", true, false},
+	{"BucketSort", BucketSort, nil, "O(n)", "O(n)", "O(n^2)", "O(n)", true, false},
+	{"FlashSort", FlashSort, nil, "O(n)", "O(n)", "O(n^2)", "O(n)", false, false},
+	{"SpreadSort", SpreadSort, nil, "O(n)", "O(n log(n))", "O(n log(n))", "O(n)", false, false},
+	{"QuickSortHoare", QuickSortHoare, QuickSortHoareFunc, "O(n log(n))", "O(n log(n))", "O(n^2)", "O(n)", false, true},
+	{"QuickSortHoareM3", QuickSortHoareM3, QuickSortHoareM3Func, "O(n log(n))", "O(n log(n))", "O(n^2)", "O(n)", false, true},
+	{"QuickSortLomuto", QuickSortLomuto, QuickSortLomutoFunc, "O(n log(n))", "O(n log(n))", "O(n^2)", "O(n)", false, true},
+}
+
+// Algorithms returns all sorting algorithms of this package.
+// The returned slice is a copy and can be modified by the caller.
+func Algorithms() []Algorithm {
+	list := make([]Algorithm, len(algorithms))
+	copy(list, algorithms)
+	return list
+}
+
+// LookupAlgorithm returns the algorithm with the given name, ignoring case.
+// The second result is false if there is no such algorithm.
+func LookupAlgorithm(name string) (Algorithm, bool) {
+	for _, a := range algorithms {
+		if strings.EqualFold(a.Name, name) {
+			return a, true
+		}
+	}
+	return Algorithm{}, false
+}
@@ -0,0 +1,55 @@
+package goalgorithms
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAlgorithms(t *testing.T) {
+	// Distribution sorts need the values themselves and have no SortFunc.
+	distribution := map[string]bool{"BucketSort": true, "FlashSort": true, "SpreadSort": true}
+	seen := map[string]bool{}
+	for _, a := range Algorithms() {
+		if seen[a.Name] {
+			t.Errorf("algorithm %s is listed twice", a.Name)
+		}
+		seen[a.Name] = true
+		if a.Sort == nil {
+			t.Errorf("algorithm %s has no Sort", a.Name)
+		}
+		if (a.SortFunc == nil) != distribution[a.Name] {
+			t.Errorf("algorithm %s: SortFunc == nil is %v, want %v", a.Name, a.SortFunc == nil, distribution[a.Name])
+		}
+		if a.Best == "" || a.Average == "" || a.Worst == "" || a.Space == "" {
+			t.Errorf("algorithm %s has no complexity classes", a.Name)
+		}
+	}
+}
+
+func TestLookupAlgorithm(t *testing.T) {
+	tests := []struct {
+		name     string
+		wantName string
+		wantOK   bool
+	}{
+		{"QuickSortHoare", "QuickSortHoare", true},
+		{"mergesorttopdown2", "MergeSortTopDown2", true},
+		{"BogoSort", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LookupAlgorithm(tt.name)
+			if ok != tt.wantOK || got.Name != tt.wantName {
+				t.Fatalf("LookupAlgorithm(%q) = %v, %v, want %v, %v", tt.name, got.Name, ok, tt.wantName, tt.wantOK)
+			}
+			if ok {
+				list := []int{3, 1, 2}
+				got.Sort(list)
+				if !reflect.DeepEqual(list, []int{1, 2, 3}) {
+					t.Errorf("LookupAlgorithm(%q).Sort() = %v, want %v", tt.name, list, []int{1, 2, 3})
+				}
+			}
+		})
+	}
+}
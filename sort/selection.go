@@ -1,13 +1,26 @@
 package goalgorithms
 
+import "cmp"
+
 // SelectionSort sorts an int slice in ascending order.
 // Worst-case time compexity: O(n^2)
 // Worst-case space compexity: O(n)
 func SelectionSort(a []int) {
+	SelectionSortFunc(a, cmp.Compare[int])
+}
+
+// SelectionSortTemp is variant of selection sort with temp varaible for min value.
+func SelectionSortTemp(a []int) {
+	SelectionSortTempFunc(a, cmp.Compare[int])
+}
+
+// SelectionSortFunc is a generic variant of SelectionSort that sorts a in
+// ascending order as determined by cmp.
+func SelectionSortFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
 	for i := 0; i < len(a)-1; i++ {
 		min := i
 		for k := i + 1; k < len(a); k++ {
-			if a[k] < a[min] {
+			if cmp(a[k], a[min]) < 0 {
 				min = k
 			}
 		}
@@ -15,13 +28,14 @@ func SelectionSort(a []int) {
 	}
 }
 
-// SelectionSortTemp is variant of selection sort with temp varaible for min value.
-func SelectionSortTemp(a []int) {
+// SelectionSortTempFunc is a generic variant of SelectionSortTemp that sorts
+// a in ascending order as determined by cmp.
+func SelectionSortTempFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
 	for i := 0; i < len(a)-1; i++ {
 		min := a[i]
 		m := i
 		for k := i + 1; k < len(a); k++ {
-			if a[k] < min {
+			if cmp(a[k], min) < 0 {
 				min = a[k]
 				m = k
 			}
@@ -29,3 +43,47 @@ func SelectionSortTemp(a []int) {
 		a[i], a[m] = min, a[i]
 	}
 }
+
+func selectionSortMinSwap[E any](a []E, cmp func(a, b E) int, write func(i int, v E)) {
+	for i := 0; i < len(a)-1; i++ {
+		min := i
+		for k := i + 1; k < len(a); k++ {
+			if cmp(a[k], a[min]) < 0 {
+				min = k
+			}
+		}
+		if min != i {
+			v := a[i]
+			write(i, a[min])
+			write(min, v)
+		}
+	}
+}
+
+// SelectionSortMinSwap is a variant of selection sort that only swaps when the
+// smallest remaining element is not already in place. It makes at most n-1
+// swaps, which makes it a simple alternative to CycleSort when writes are
+// expensive: it can write an element more than once, but at most 2(n-1) writes
+// are made in total.
+// Worst-case time compexity: O(n^2)
+// Worst-case space compexity: O(1)
+func SelectionSortMinSwap(a []int) {
+	SelectionSortMinSwapFunc(a, cmp.Compare[int])
+}
+
+// SelectionSortMinSwapWrites sorts a like SelectionSortMinSwap and returns the
+// number of writes made to a.
+func SelectionSortMinSwapWrites(a []int) int {
+	writes := 0
+	selectionSortMinSwap(a, cmp.Compare[int], func(i, v int) {
+		a[i] = v
+		writes++
+	})
+	return writes
+}
+
+// SelectionSortMinSwapFunc is a generic variant of SelectionSortMinSwap that
+// sorts a in ascending order as determined by cmp.
+func SelectionSortMinSwapFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	selectionSortMinSwap(a, cmp, func(i int, v E) { a[i] = v })
+}
@@ -0,0 +1,202 @@
+package goalgorithms
+
+import (
+	"cmp"
+
+	search "github.com/quasoft/goalgorithms/search"
+)
+
+// The functions below treat sorted int slices as sets. Inputs must be sorted in
+// ascending order and may hold repeated values, which count as one element.
+// Results are sorted and hold every value once.
+//
+// Functions with the Into suffix write the result to the start of dst and
+// return dst resliced to the length of the result. A new slice is allocated,
+// as append would do, only if dst is not large enough. The result can be
+// written over the first operand a, by passing a[:0] as dst, for
+// Intersection, Difference and Dedupe, as they never write past the element
+// of a they are reading.
+
+// mergeSets merges a and b with the merge step of merge.go and writes to dst
+// the values found only in a if onlyA is true, in both if both is true and
+// only in b if onlyB is true.
+func mergeSets(dst, a, b []int, onlyA, both, onlyB bool) []int {
+	dst = dst[:0]
+	l, r := 0, 0
+	// Stop as soon as the rest of the other slice cannot add to the result.
+	for l < len(a) && (r < len(b) || onlyA) || r < len(b) && onlyB {
+		var v int
+		if mergeTakesLeft(a, l, b, r, cmp.Compare[int]) {
+			v = a[l]
+		} else {
+			v = b[r]
+		}
+		inA, inB := false, false
+		for ; l < len(a) && a[l] == v; l++ {
+			inA = true
+		}
+		for ; r < len(b) && b[r] == v; r++ {
+			inB = true
+		}
+
+		if inA && inB && both || inA && !inB && onlyA || !inA && inB && onlyB {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// Union returns the values found in a, b or both.
+// Time compexity: O(n + m)
+func Union(a, b []int) []int {
+	return UnionInto(nil, a, b)
+}
+
+// UnionInto is like Union, but writes the result to dst.
+func UnionInto(dst, a, b []int) []int {
+	return mergeSets(dst, a, b, true, true, true)
+}
+
+// Intersection returns the values found in both a and b.
+// Time compexity: O(n + m)
+func Intersection(a, b []int) []int {
+	return IntersectionInto(nil, a, b)
+}
+
+// IntersectionInto is like Intersection, but writes the result to dst.
+func IntersectionInto(dst, a, b []int) []int {
+	return mergeSets(dst, a, b, false, true, false)
+}
+
+// Difference returns the values of a that are not found in b.
+// Time compexity: O(n + m)
+func Difference(a, b []int) []int {
+	return DifferenceInto(nil, a, b)
+}
+
+// DifferenceInto is like Difference, but writes the result to dst.
+func DifferenceInto(dst, a, b []int) []int {
+	return mergeSets(dst, a, b, true, false, false)
+}
+
+// SymmetricDifference returns the values found in either a or b, but not in both.
+// Time compexity: O(n + m)
+func SymmetricDifference(a, b []int) []int {
+	return SymmetricDifferenceInto(nil, a, b)
+}
+
+// SymmetricDifferenceInto is like SymmetricDifference, but writes the result to dst.
+func SymmetricDifferenceInto(dst, a, b []int) []int {
+	return mergeSets(dst, a, b, true, false, true)
+}
+
+// Dedupe returns the values of a without repetitions.
+// Time compexity: O(n)
+func Dedupe(a []int) []int {
+	return DedupeInto(nil, a)
+}
+
+// DedupeInto is like Dedupe, but writes the result to dst.
+func DedupeInto(dst, a []int) []int {
+	return mergeSets(dst, a, nil, true, false, false)
+}
+
+// IsSubset reports whether every value of a is also found in b.
+// Time compexity: O(n + m)
+func IsSubset(a, b []int) bool {
+	r := 0
+	for _, v := range a {
+		for r < len(b) && b[r] < v {
+			r++
+		}
+		if r == len(b) || b[r] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// gallop searches b[start:] for v by probing positions start, start+1,
+// start+3, start+7, ... until it passes v, and then binary searching between
+// the last two probes with BinarySearchLinear. It returns whether v was found
+// and the position lo, such that all values of b[start:lo] are smaller than v.
+// Takes O(log(d)) time, where d is the distance from start to v.
+func gallop(v int, b []int, start int) (int, bool) {
+	lo, hi := start, start
+	for step := 1; hi < len(b) && b[hi] < v; step *= 2 {
+		lo = hi + 1
+		hi = start + 2*step - 1
+	}
+	hi = min(hi+1, len(b))
+	_, found := search.BinarySearchLinear(v, b[lo:hi])
+	return lo, found
+}
+
+// IntersectionGallop returns the values found in both a and b like
+// Intersection, but instead of merging the two slices, it looks up every value
+// of the smaller slice in the larger one by galloping from the position of the
+// previous value. This is much faster when one slice is much smaller.
+// Time compexity: O(m log(n/m)), where m is the length of the smaller slice.
+func IntersectionGallop(a, b []int) []int {
+	return IntersectionGallopInto(nil, a, b)
+}
+
+// IntersectionGallopInto is like IntersectionGallop, but writes the result to dst.
+func IntersectionGallopInto(dst, a, b []int) []int {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	dst = dst[:0]
+	start := 0
+	for i, v := range a {
+		if i > 0 && a[i-1] == v {
+			continue
+		}
+		var found bool
+		start, found = gallop(v, b, start)
+		if found {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DifferenceGallop returns the values of a that are not found in b like
+// Difference, but looks up every value of a in b by galloping. This is much
+// faster when a is much smaller than b.
+// Time compexity: O(n log(m/n)), where n is the length of a.
+func DifferenceGallop(a, b []int) []int {
+	return DifferenceGallopInto(nil, a, b)
+}
+
+// DifferenceGallopInto is like DifferenceGallop, but writes the result to dst.
+func DifferenceGallopInto(dst, a, b []int) []int {
+	dst = dst[:0]
+	start := 0
+	for i, v := range a {
+		if i > 0 && a[i-1] == v {
+			continue
+		}
+		var found bool
+		start, found = gallop(v, b, start)
+		if !found {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// IsSubsetGallop reports whether every value of a is also found in b like
+// IsSubset, but looks up every value of a in b by galloping. This is much
+// faster when a is much smaller than b.
+// Time compexity: O(n log(m/n)), where n is the length of a.
+func IsSubsetGallop(a, b []int) bool {
+	start := 0
+	for _, v := range a {
+		var found bool
+		if start, found = gallop(v, b, start); !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// naiveSetOp computes a set operation with a map, keeping the values that are
+// in a and b as told by keep.
+func naiveSetOp(a, b []int, keep func(inA, inB bool) bool) []int {
+	in := map[int][2]bool{}
+	for _, v := range a {
+		in[v] = [2]bool{true, in[v][1]}
+	}
+	for _, v := range b {
+		in[v] = [2]bool{in[v][0], true}
+	}
+	var res []int
+	for v, f := range in {
+		if keep(f[0], f[1]) {
+			res = append(res, v)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+var setOps = []struct {
+	name   string
+	op     func(a, b []int) []int
+	opInto func(dst, a, b []int) []int
+	keep   func(inA, inB bool) bool
+}{
+	{"Union", Union, UnionInto, func(inA, inB bool) bool { return inA || inB }},
+	{"Intersection", Intersection, IntersectionInto, func(inA, inB bool) bool { return inA && inB }},
+	{"IntersectionGallop", IntersectionGallop, IntersectionGallopInto, func(inA, inB bool) bool { return inA && inB }},
+	{"Difference", Difference, DifferenceInto, func(inA, inB bool) bool { return inA && !inB }},
+	{"DifferenceGallop", DifferenceGallop, DifferenceGallopInto, func(inA, inB bool) bool { return inA && !inB }},
+	{"SymmetricDifference", SymmetricDifference, SymmetricDifferenceInto, func(inA, inB bool) bool { return inA != inB }},
+	{"Dedupe", func(a, b []int) []int { return Dedupe(a) }, func(dst, a, b []int) []int { return DedupeInto(dst, a) }, func(inA, inB bool) bool { return inA }},
+}
+
+func TestSetOps(t *testing.T) {
+	a := []int{1, 1, 2, 4, 6, 6, 6, 9}
+	b := []int{0, 2, 2, 3, 6, 10}
+	tests := map[string][]int{
+		"Union":               {0, 1, 2, 3, 4, 6, 9, 10},
+		"Intersection":        {2, 6},
+		"IntersectionGallop":  {2, 6},
+		"Difference":          {1, 4, 9},
+		"DifferenceGallop":    {1, 4, 9},
+		"SymmetricDifference": {0, 1, 3, 4, 9, 10},
+		"Dedupe":              {1, 2, 4, 6, 9},
+	}
+	for _, op := range setOps {
+		t.Run(op.name, func(t *testing.T) {
+			want := tests[op.name]
+			if got := op.op(a, b); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s(%v, %v) = %v, want %v", op.name, a, b, got, want)
+			}
+			if got := op.op(nil, nil); len(got) != 0 {
+				t.Errorf("%s(nil, nil) = %v, want []", op.name, got)
+			}
+
+			// The result must be written to dst if it fits.
+			dst := make([]int, 0, 16)
+			got := op.opInto(dst, a, b)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%sInto(%v, %v) = %v, want %v", op.name, a, b, got, want)
+			} else if &got[0] != &dst[:1][0] {
+				t.Errorf("%sInto() did not write to dst", op.name)
+			}
+		})
+	}
+}
+
+func TestSetOps_InPlace(t *testing.T) {
+	for _, op := range []struct {
+		name   string
+		opInto func(dst, a, b []int) []int
+	}{
+		{"IntersectionInto", IntersectionInto},
+		{"DifferenceInto", DifferenceInto},
+		{"DedupeInto", func(dst, a, b []int) []int { return DedupeInto(dst, a) }},
+	} {
+		t.Run(op.name, func(t *testing.T) {
+			a := []int{1, 1, 2, 4, 6, 6, 6, 9}
+			b := []int{0, 2, 2, 3, 6, 10}
+			want := op.opInto(nil, a, b)
+			if got := op.opInto(a[:0], a, b); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s(a[:0], a, b) = %v, want %v", op.name, got, want)
+			}
+		})
+	}
+}
+
+// randomSet returns n sorted random values below max, with repetitions.
+func randomSet(rnd *rand.Rand, n, max int) []int {
+	a := make([]int, n)
+	for i := range a {
+		a[i] = rnd.Intn(max)
+	}
+	slices.Sort(a)
+	return a
+}
+
+func TestSetOps_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a := randomSet(rnd, rnd.Intn(50), 100)
+		b := randomSet(rnd, rnd.Intn(500), 100+rnd.Intn(1000))
+		if i%2 == 1 {
+			a, b = b, a
+		}
+		for _, op := range setOps {
+			want := naiveSetOp(a, b, op.keep)
+			if got := op.op(a, b); !slices.Equal(got, want) {
+				t.Fatalf("%s(%v, %v) = %v, want %v", op.name, a, b, got, want)
+			}
+		}
+
+		want := len(naiveSetOp(a, b, func(inA, inB bool) bool { return inA && !inB })) == 0
+		if got := IsSubset(a, b); got != want {
+			t.Fatalf("IsSubset(%v, %v) = %v, want %v", a, b, got, want)
+		}
+		if got := IsSubsetGallop(a, b); got != want {
+			t.Fatalf("IsSubsetGallop(%v, %v) = %v, want %v", a, b, got, want)
+		}
+		sub := Intersection(a, b)
+		if !IsSubset(sub, b) || !IsSubsetGallop(sub, a) {
+			t.Fatalf("Intersection(%v, %v) = %v, which is not a subset of both", a, b, sub)
+		}
+	}
+}
+
+func BenchmarkIntersection(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	large := randomSet(rnd, 1000000, 10000000)
+	for _, n := range []int{10, 1000, 100000, 1000000} {
+		small := randomSet(rnd, n, 10000000)
+		dst := make([]int, 0, n)
+		for _, impl := range []struct {
+			name string
+			op   func(dst, a, b []int) []int
+		}{
+			{"Merge", IntersectionInto},
+			{"Gallop", IntersectionGallopInto},
+		} {
+			b.Run(fmt.Sprintf("%s_%d", impl.name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					dst = impl.op(dst, small, large)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"math"
+)
+
+// leonardo holds the Leonardo numbers L(0) = L(1) = 1, L(k) = L(k-1) + L(k-2) + 1
+// that fit in an int. A Leonardo tree of order k has L(k) nodes.
+var leonardo = func() []int {
+	l := []int{1, 1}
+	for {
+		a, b := l[len(l)-2], l[len(l)-1]
+		if b > math.MaxInt-a-1 {
+			return l
+		}
+		l = append(l, a+b+1)
+	}
+}()
+
+// maxLeonardoTrees is the largest number of trees a Leonardo heap of any int
+// size can hold. The orders of its trees are distinct, so there are at most
+// as many trees as there are Leonardo numbers.
+const maxLeonardoTrees = 96
+
+// A Leonardo tree of order k with its root at r occupies a[r-L(k)+1:r+1].
+// Its right subtree has order k-2 and root r-1, its left subtree has order
+// k-1 and root r-1-L(k-2).
+
+// SmoothSort performs in-place sort of int slice in ascending order using
+// Dijkstra's smoothsort. It is a heapsort on a forest of Leonardo trees,
+// whose roots are kept in ascending order, so the largest element is always at
+// the end of the heap. Sorted parts of the input need little work, which makes
+// it adaptive like insertion sort, but without a quadratic worst case.
+// Best time compexity: O(n), on already sorted input
+// Worst case time compexity: O(n log(n))
+// Worst case space compexity: O(1)
+func SmoothSort(a []int) {
+	SmoothSortFunc(a, cmp.Compare[int])
+}
+
+// siftLeonardoFunc restores the heap property of the tree of order k with
+// root r, whose subtrees are already heaps.
+func siftLeonardoFunc[E any](a []E, r, k int, cmp func(a, b E) int) {
+	for k >= 2 {
+		right := r - 1
+		left := right - leonardo[k-2]
+		child, order := right, k-2
+		if cmp(a[left], a[right]) > 0 {
+			child, order = left, k-1
+		}
+		if cmp(a[r], a[child]) >= 0 {
+			return
+		}
+		a[r], a[child] = a[child], a[r]
+		r, k = child, order
+	}
+}
+
+// rectifyLeonardoFunc moves the root r of tree t of the heap with the given
+// tree orders left along the roots of the preceding trees, until the roots are
+// in ascending order, and then sifts it into the tree where it stopped.
+func rectifyLeonardoFunc[E any](a []E, orders []int, t, r int, cmp func(a, b E) int) {
+	for ; t > 0; t-- {
+		prev := r - leonardo[orders[t]]
+		if cmp(a[prev], a[r]) <= 0 {
+			break
+		}
+		// The root of the previous tree can only be moved here if it is
+		// not smaller than the children of this root.
+		if k := orders[t]; k >= 2 {
+			right := r - 1
+			left := right - leonardo[k-2]
+			if cmp(a[prev], a[left]) <= 0 || cmp(a[prev], a[right]) <= 0 {
+				break
+			}
+		}
+		a[prev], a[r] = a[r], a[prev]
+		r = prev
+	}
+	siftLeonardoFunc(a, r, orders[t], cmp)
+}
+
+// SmoothSortFunc is a generic variant of SmoothSort that sorts a in ascending
+// order as determined by cmp.
+func SmoothSortFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	if len(a) < 2 {
+		return
+	}
+	var buf [maxLeonardoTrees]int
+	orders := buf[:0]
+
+	// Add elements to the heap one by one. Two trees of consecutive orders
+	// k+1 and k are merged with the new element as their root.
+	for i := range a {
+		if n := len(orders); n >= 2 && orders[n-2] == orders[n-1]+1 {
+			orders[n-2]++
+			orders = orders[:n-1]
+		} else if n >= 1 && orders[n-1] == 1 {
+			orders = append(orders, 0)
+		} else {
+			orders = append(orders, 1)
+		}
+		rectifyLeonardoFunc(a, orders, len(orders)-1, i, cmp)
+	}
+
+	// Remove the largest element, which is the root of the last tree, and
+	// put the two subtrees that it leaves behind in order.
+	for i := len(a) - 1; i > 0; i-- {
+		k := orders[len(orders)-1]
+		orders = orders[:len(orders)-1]
+		if k < 2 {
+			continue
+		}
+		orders = append(orders, k-1, k-2)
+		right := i - 1
+		left := right - leonardo[k-2]
+		rectifyLeonardoFunc(a, orders, len(orders)-2, left, cmp)
+		rectifyLeonardoFunc(a, orders, len(orders)-1, right, cmp)
+	}
+}
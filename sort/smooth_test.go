@@ -0,0 +1,57 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestSmoothSort_Large(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1000, 4181, 10000} {
+		list := make([]int, n)
+		for i := range list {
+			list[i] = rnd.Intn(n / 3)
+		}
+		want := slices.Clone(list)
+		slices.Sort(want)
+		SmoothSort(list)
+		if !slices.Equal(list, want) {
+			t.Fatalf("SmoothSort() of %d values did not sort them", n)
+		}
+	}
+}
+
+// TestSortAdaptive checks that the adaptive sorts need a linear number of
+// comparisons on already sorted input.
+func TestSortAdaptive(t *testing.T) {
+	const n = 10000
+	sorted := make([]int, n)
+	for i := range sorted {
+		sorted[i] = i / 3
+	}
+	for _, impl := range []struct {
+		name string
+		sort func([]int, func(a, b int) int)
+	}{
+		{"SmoothSortFunc", SmoothSortFunc},
+		{"PatienceSortFunc", PatienceSortFunc},
+		{"InsertionSortSwapOnceFunc", InsertionSortSwapOnceFunc},
+	} {
+		t.Run(impl.name, func(t *testing.T) {
+			compares := 0
+			list := slices.Clone(sorted)
+			impl.sort(list, func(a, b int) int {
+				compares++
+				return cmp.Compare(a, b)
+			})
+			if !slices.Equal(list, sorted) {
+				t.Fatalf("%s() changed sorted input", impl.name)
+			}
+			if compares > 5*n {
+				t.Errorf("%s() made %d comparisons on %d sorted values, want at most %d", impl.name, compares, n, 5*n)
+			}
+		})
+	}
+}
@@ -7,26 +7,6 @@ import (
 )
 
 func TestSort(t *testing.T) {
-	implementations := []struct {
-		name string
-		sort func([]int)
-	}{
-		{"InsertionSortSwap", InsertionSortSwap},
-		{"InsertionSortSwapOnce", InsertionSortSwapOnce},
-		{"InsertionSortShift", InsertionSortShift},
-		{"SelectionSort", SelectionSort},
-		{"SelectionSortTemp", SelectionSortTemp},
-		{"BubbleSort", BubbleSort},
-		{"BubbleSortTwoLoops", BubbleSortTwoLoops},
-		{"MergeSortTopDown", MergeSortTopDown},
-		{"MergeSortTopDown2", MergeSortTopDown2},
-		{"MergeSortTopDown3", MergeSortTopDown3},
-		{"MergeSortBottomUp1", MergeSortBottomUp1},
-		{"MergeSortBottomUp2", MergeSortBottomUp2},
-		{"QuickSortHoare", QuickSortHoare},
-		{"QuickSortHoareM3", QuickSortHoareM3},
-		{"QuickSortLomuto", QuickSortLomuto},
-	}
 	tests := []struct {
 		name string
 		list []int
@@ -49,15 +29,15 @@ func TestSort(t *testing.T) {
 			},
 		},
 	}
-	for _, impl := range implementations {
+	for _, impl := range Algorithms() {
 		for _, tt := range tests {
 			tosort := make([]int, len(tt.list))
 			copy(tosort, tt.list)
 			t.Run(tt.name, func(t *testing.T) {
-				impl.sort(tosort)
+				impl.Sort(tosort)
 				got := tosort
 				if !reflect.DeepEqual(got, tt.want) {
-					t.Fatalf("%s(%v) = %v, want %v", impl.name, tt.list, got, tt.want)
+					t.Fatalf("%s(%v) = %v, want %v", impl.Name, tt.list, got, tt.want)
 				}
 			})
 		}
@@ -99,33 +79,13 @@ func BenchmarkSort(b *testing.B) {
 		}},
 	}
 
-	implementations := []struct {
-		name string
-		sort func([]int)
-	}{
-		{"InsertionSortSwap", InsertionSortSwap},
-		{"InsertionSortSwapOnce", InsertionSortSwapOnce},
-		{"InsertionSortShift", InsertionSortShift},
-		{"SelectionSort", SelectionSort},
-		{"SelectionSortTemp", SelectionSortTemp},
-		{"BubbleSort", BubbleSort},
-		{"BubbleSortTwoLoops", BubbleSortTwoLoops},
-		{"MergeSortTopDown", MergeSortTopDown},
-		{"MergeSortTopDown2", MergeSortTopDown2},
-		{"MergeSortTopDown3", MergeSortTopDown3},
-		{"MergeSortBottomUp1", MergeSortBottomUp1},
-		{"MergeSortBottomUp2", MergeSortBottomUp2},
-		{"QuickSortHoare", QuickSortHoare},
-		{"QuickSortHoareM3", QuickSortHoareM3},
-		{"QuickSortLomuto", QuickSortLomuto},
-	}
 	for _, tt := range tests {
-		for _, impl := range implementations {
-			b.Run(impl.name+fmt.Sprintf("_%s_%d", tt.name, len(tt.list)), func(b *testing.B) {
+		for _, impl := range Algorithms() {
+			b.Run(impl.Name+fmt.Sprintf("_%s_%d", tt.name, len(tt.list)), func(b *testing.B) {
 				for i := 0; i < b.N; i++ {
 					tosort := make([]int, len(tt.list))
 					copy(tosort, tt.list)
-					impl.sort(tosort)
+					impl.Sort(tosort)
 				}
 			})
 		}
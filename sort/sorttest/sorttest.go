@@ -0,0 +1,206 @@
+// Package goalgorithms implements a conformance suite for int sorting
+// algorithms. Algorithms register themselves with Register and are then
+// checked by Run and Fuzz for the properties every sort must have: the output
+// is sorted, it is a permutation of the input, sorting again changes nothing
+// and, for stable algorithms, records with equal keys keep their order.
+package goalgorithms
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// Record is the element type used to check stability. Records are compared
+// by Key only, Seq holds the original position of the record.
+type Record struct {
+	Key int
+	Seq int
+}
+
+// Algorithm describes a sort to be checked by the suite.
+type Algorithm struct {
+	Name string
+	// Sort sorts an int slice in-place in ascending order.
+	Sort func([]int)
+	// SortFunc sorts records in ascending order as determined by cmp.
+	// It is required for stable algorithms and used to check stability.
+	SortFunc func(a []Record, cmp func(a, b Record) int)
+	// Stable is true if the algorithm keeps equal elements in their original order.
+	Stable bool
+}
+
+var (
+	mu         sync.Mutex
+	algorithms []Algorithm
+)
+
+// Register adds an algorithm to the suite.
+// It panics if an algorithm with the same name is already registered,
+// or if a stable algorithm has no SortFunc.
+func Register(a Algorithm) {
+	mu.Lock()
+	defer mu.Unlock()
+	if a.Stable && a.SortFunc == nil {
+		panic(fmt.Sprintf("sorttest: stable algorithm %s registered without SortFunc", a.Name))
+	}
+	for _, r := range algorithms {
+		if r.Name == a.Name {
+			panic(fmt.Sprintf("sorttest: algorithm %s registered twice", a.Name))
+		}
+	}
+	algorithms = append(algorithms, a)
+}
+
+// Registered returns the registered algorithms in order of registration.
+func Registered() []Algorithm {
+	mu.Lock()
+	defer mu.Unlock()
+	return slices.Clone(algorithms)
+}
+
+// Case is a named input for the suite.
+type Case struct {
+	Name string
+	List []int
+}
+
+// EdgeCases returns the inputs every algorithm is checked on: nil and empty
+// slices, a single element, all-equal values, the extremes of int and random
+// values at lengths 2^k-1, 2^k and 2^k+1 for k up to 10.
+func EdgeCases() []Case {
+	cases := []Case{
+		{"Nil", nil},
+		{"Empty", []int{}},
+		{"Single element", []int{42}},
+		{"Two equal", []int{7, 7}},
+		{"All equal", slices.Repeat([]int{5}, 100)},
+		{"Extremes", []int{math.MaxInt, 0, math.MinInt, -1, math.MaxInt, 1, math.MinInt}},
+		{"Extremes reversed", []int{math.MaxInt, math.MaxInt - 1, 1, 0, -1, math.MinInt + 1, math.MinInt}},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for k := 1; k <= 10; k++ {
+		for _, n := range []int{1<<k - 1, 1 << k, 1<<k + 1} {
+			list := make([]int, n)
+			for i := range list {
+				list[i] = rnd.Intn(n) - n/2
+			}
+			cases = append(cases, Case{fmt.Sprintf("Random of %d", n), list})
+		}
+	}
+	return cases
+}
+
+// CheckSort sorts a copy of list with sort and returns an error if the result
+// is not sorted, is not a permutation of list or changes when sorted again.
+func CheckSort(sort func([]int), list []int) error {
+	want := slices.Clone(list)
+	slices.Sort(want)
+
+	got := slices.Clone(list)
+	sort(got)
+	if !slices.IsSorted(got) {
+		return fmt.Errorf("result is not sorted: %v", got)
+	}
+	if !slices.Equal(got, want) {
+		return fmt.Errorf("result is not a permutation of the input: %v", got)
+	}
+
+	sort(got)
+	if !slices.Equal(got, want) {
+		return fmt.Errorf("sorting the sorted result changed it to %v", got)
+	}
+	return nil
+}
+
+// CheckStable sorts records built from the keys in list with sortFunc and
+// returns an error if records with equal keys changed their relative order.
+func CheckStable(sortFunc func(a []Record, cmp func(a, b Record) int), list []int) error {
+	records := make([]Record, len(list))
+	for i, key := range list {
+		records[i] = Record{key, i}
+	}
+	sortFunc(records, func(a, b Record) int {
+		if a.Key < b.Key {
+			return -1
+		} else if a.Key > b.Key {
+			return 1
+		}
+		return 0
+	})
+	for i := 1; i < len(records); i++ {
+		prev, cur := records[i-1], records[i]
+		if cur.Key < prev.Key {
+			return fmt.Errorf("records are not sorted at %d: %v, %v", i, prev, cur)
+		}
+		if cur.Key == prev.Key && cur.Seq < prev.Seq {
+			return fmt.Errorf("equal keys changed order at %d: %v, %v", i, prev, cur)
+		}
+	}
+	return nil
+}
+
+// Check runs all property checks for one algorithm on list.
+func Check(a Algorithm, list []int) error {
+	if err := CheckSort(a.Sort, list); err != nil {
+		return err
+	}
+	if a.Stable {
+		return CheckStable(a.SortFunc, list)
+	}
+	return nil
+}
+
+// Run checks every registered algorithm on every edge case as a subtest.
+func Run(t *testing.T) {
+	for _, a := range Registered() {
+		for _, tt := range EdgeCases() {
+			t.Run(a.Name+"/"+tt.Name, func(t *testing.T) {
+				if err := Check(a, tt.List); err != nil {
+					t.Fatalf("%s(%v): %v", a.Name, tt.List, err)
+				}
+			})
+		}
+	}
+}
+
+// DecodeInts turns fuzzer input into an int slice. Every two bytes make one
+// value, which keeps duplicates common. The two most extreme 16-bit values
+// are mapped to math.MinInt and math.MaxInt.
+func DecodeInts(data []byte) []int {
+	list := make([]int, len(data)/2)
+	for i := range list {
+		v := int(int16(binary.LittleEndian.Uint16(data[2*i:])))
+		switch v {
+		case math.MinInt16:
+			v = math.MinInt
+		case math.MaxInt16:
+			v = math.MaxInt
+		}
+		list[i] = v
+	}
+	return list
+}
+
+// Fuzz checks every registered algorithm on inputs generated by the fuzzer.
+// Call it from a fuzz target, e.g. func FuzzSort(f *testing.F) { sorttest.Fuzz(f) }.
+func Fuzz(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 0})
+	f.Add([]byte{3, 0, 3, 0, 3, 0, 3, 0})
+	f.Add([]byte{0xff, 0x7f, 0, 0x80, 0, 0, 0xff, 0x7f, 0, 0x80, 1, 0})
+	f.Add([]byte{9, 0, 8, 0, 7, 0, 6, 0, 5, 0, 4, 0, 3, 0, 2, 0, 1, 0})
+	algorithms := Registered()
+	f.Fuzz(func(t *testing.T, data []byte) {
+		list := DecodeInts(data)
+		for _, a := range algorithms {
+			if err := Check(a, list); err != nil {
+				t.Fatalf("%s(%v): %v", a.Name, list, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package goalgorithms
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestCheckSort(t *testing.T) {
+	tests := []struct {
+		name    string
+		sort    func([]int)
+		list    []int
+		wantErr bool
+	}{
+		{"Correct sort", slices.Sort[[]int], []int{3, 1, 2}, false},
+		{"Not sorted", func([]int) {}, []int{3, 1, 2}, true},
+		{"Not a permutation", func(a []int) {
+			for i := range a {
+				a[i] = i
+			}
+		}, []int{3, 1, 2}, true},
+		{"Not idempotent", func(a []int) {
+			slices.Sort(a)
+			if a[0] == 1 {
+				a[0], a[1] = a[1], a[0]
+			}
+		}, []int{3, 1, 2}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckSort(tt.sort, tt.list)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckSort(%v) = %v, want error: %v", tt.list, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckStable(t *testing.T) {
+	unstable := func(a []Record, cmp func(a, b Record) int) {
+		slices.SortStableFunc(a, cmp)
+		slices.Reverse(a)
+		slices.SortStableFunc(a, cmp)
+	}
+	list := []int{2, 1, 2, 1, 2}
+	if err := CheckStable(slices.SortStableFunc[[]Record], list); err != nil {
+		t.Errorf("CheckStable(slices.SortStableFunc, %v) = %v, want nil", list, err)
+	}
+	if err := CheckStable(unstable, list); err == nil {
+		t.Errorf("CheckStable(unstable, %v) = nil, want error", list)
+	}
+}
+
+func TestDecodeInts(t *testing.T) {
+	got := DecodeInts([]byte{1, 0, 0xff, 0xff, 0xff, 0x7f, 0, 0x80, 5})
+	want := []int{1, -1, math.MaxInt, math.MinInt}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeInts() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,141 @@
+package goalgorithms
+
+import "cmp"
+
+// Parameters of MergeSortTiled. A tile of 32K ints takes 256KB and fits in
+// a typical L2 cache together with its share of the merge buffer.
+const (
+	tiledRunSize  = 32
+	tiledTileSize = 1 << 15
+	tiledFanout   = 16
+)
+
+// MergeSortTiled performs a stable in-place sort of int slice in ascending order
+// using a cache-aware merge sort.
+// Tiles that fit in L2 cache are sorted first with insertion sort on short runs
+// followed by binary merges that stay in cache. The sorted tiles are then
+// combined with 16-way merges, so the whole slice is streamed through memory
+// only 1 + log16(n/32K) times instead of log2(n) times as in MergeSortBottomUp2.
+// Worst case time compexity: O(n log(n))
+// Worst case space compexity: O(n)
+func MergeSortTiled(a []int) {
+	MergeSortTiledFunc(a, cmp.Compare[int])
+}
+
+// MergeSortTiledFunc is a generic variant of MergeSortTiled that sorts a in
+// ascending order as determined by cmp.
+func MergeSortTiledFunc[S ~[]E, E any](a S, cmp func(a, b E) int) {
+	var elements int
+	mergeSortTiledFunc(a, tiledTileSize, tiledFanout, cmp, &elements)
+}
+
+// mergeSortTiledFunc sorts a with tiles of the given size and merges of the
+// given fanout. It adds the length of every run that it sorts or merges to
+// elements, so that tests can count the passes it makes over the slice.
+func mergeSortTiledFunc[E any](a []E, tile, fanout int, cmp func(a, b E) int, elements *int) {
+	if len(a) < 2 {
+		return
+	}
+	b := make([]E, len(a), len(a))
+	for left := 0; left < len(a); left += tile {
+		right := min(left+tile, len(a))
+		sortTileFunc(a[left:right], b[left:right], cmp)
+		*elements += right - left
+	}
+
+	src, dst := a, b
+	heap := make([]int, 0, fanout)
+	pos := make([]int, fanout)
+	end := make([]int, fanout)
+	for run := tile; run < len(a); run *= fanout {
+		for left := 0; left < len(a); left += run * fanout {
+			multiwayMergeFunc(src, dst, left, run, fanout, heap, pos, end, cmp)
+			*elements += min(left+run*fanout, len(a)) - left
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &a[0] {
+		copy(a, src)
+	}
+}
+
+// sortTileFunc sorts a using b as scratch space of the same length, by insertion
+// sorting short runs and merging them bottom up.
+func sortTileFunc[E any](a, b []E, cmp func(a, b E) int) {
+	for left := 0; left < len(a); left += tiledRunSize {
+		InsertionSortSwapOnceFunc(a[left:min(left+tiledRunSize, len(a))], cmp)
+	}
+	for s := tiledRunSize; s < len(a); s *= 2 {
+		for left := 0; left < len(a); left += s * 2 {
+			l := left
+			r := left + s
+			ls := min(r, len(a))
+			rs := min(r+s, len(a))
+			for z := left; z < rs; z++ {
+				if mergeTakesLeft(a[:ls], l, a[:rs], r, cmp) {
+					b[z] = a[l]
+					l++
+				} else {
+					b[z] = a[r]
+					r++
+				}
+			}
+
+			for z := left; z < rs; z++ {
+				a[z] = b[z]
+			}
+		}
+	}
+}
+
+// multiwayMergeFunc merges up to fanout sorted runs of length run, starting at
+// left in src, into the same positions in dst. A binary heap of run indices
+// picks the smallest head; ties go to the earlier run to keep the merge stable.
+// heap, pos and end are scratch space reused between calls.
+func multiwayMergeFunc[E any](src, dst []E, left, run, fanout int, heap, pos, end []int, cmp func(a, b E) int) {
+	less := func(i, j int) bool {
+		c := cmp(src[pos[i]], src[pos[j]])
+		return c < 0 || c == 0 && i < j
+	}
+	down := func(i int) {
+		for {
+			m := i
+			if l := 2*i + 1; l < len(heap) && less(heap[l], heap[m]) {
+				m = l
+			}
+			if r := 2*i + 2; r < len(heap) && less(heap[r], heap[m]) {
+				m = r
+			}
+			if m == i {
+				return
+			}
+			heap[i], heap[m] = heap[m], heap[i]
+			i = m
+		}
+	}
+
+	heap = heap[:0]
+	for k := 0; k < fanout; k++ {
+		start := left + k*run
+		if start >= len(src) {
+			break
+		}
+		pos[k] = start
+		end[k] = min(start+run, len(src))
+		heap = append(heap, k)
+	}
+	for i := len(heap)/2 - 1; i >= 0; i-- {
+		down(i)
+	}
+
+	for z := left; len(heap) > 0; z++ {
+		k := heap[0]
+		dst[z] = src[pos[k]]
+		pos[k]++
+		if pos[k] == end[k] {
+			heap[0] = heap[len(heap)-1]
+			heap = heap[:len(heap)-1]
+		}
+		down(0)
+	}
+}
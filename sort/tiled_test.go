@@ -0,0 +1,131 @@
+package goalgorithms
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMergeSortTiled_SmallTiles(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name         string
+		n            int
+		tile, fanout int
+		wantPasses   int
+	}{
+		{"One tile", 100, 128, 4, 1},
+		{"One merge level", 1000, 256, 4, 2},
+		{"Two merge levels", 3000, 256, 4, 3},
+		{"Partial last tile and group", 4099, 64, 3, 5},
+		{"Binary fanout", 5000, 32, 2, 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := make([]int, tt.n)
+			for i := range list {
+				list[i] = rnd.Intn(tt.n / 2)
+			}
+			want := make([]int, len(list))
+			copy(want, list)
+			sort.Ints(want)
+
+			passes := countPasses(len(list), func(elements *int) {
+				mergeSortTiledFunc(list, tt.tile, tt.fanout, cmp.Compare[int], elements)
+			})
+			if !reflect.DeepEqual(list, want) {
+				t.Fatalf("mergeSortTiled(n=%d, tile=%d, fanout=%d) did not sort the slice", tt.n, tt.tile, tt.fanout)
+			}
+			if passes != float64(tt.wantPasses) {
+				t.Errorf("mergeSortTiled(n=%d, tile=%d, fanout=%d) made %v passes, want %d", tt.n, tt.tile, tt.fanout, passes, tt.wantPasses)
+			}
+		})
+	}
+}
+
+// countPasses runs sort, which sorts n elements, and returns the number of
+// passes over them that it counted in elements.
+func countPasses(n int, sort func(elements *int)) float64 {
+	elements := 0
+	sort(&elements)
+	return float64(elements) / float64(n)
+}
+
+// tiledSort and funnelSort sort an int slice like MergeSortTiled and
+// FunnelSort, and count the elements of the runs they sort or merge.
+func tiledSort(a []int, elements *int) {
+	mergeSortTiledFunc(a, tiledTileSize, tiledFanout, cmp.Compare[int], elements)
+}
+
+func funnelSort(a []int, elements *int) {
+	funnelSortFunc(a, make([]int, len(a)), cmp.Compare[int], elements)
+}
+
+func TestFunnelSort_Passes(t *testing.T) {
+	tests := []struct {
+		n          int
+		wantPasses float64
+	}{
+		{64, 1},
+		{65, 2},
+		{1000, 3},
+		{100000, 4},
+	}
+	for _, tt := range tests {
+		list := rand.New(rand.NewSource(1)).Perm(tt.n)
+		if got := countPasses(tt.n, func(elements *int) { funnelSort(list, elements) }); got != tt.wantPasses {
+			t.Errorf("FunnelSort() of %d values made %v passes, want %v", tt.n, got, tt.wantPasses)
+		}
+	}
+}
+
+func TestFunnelSort_Large(t *testing.T) {
+	for _, n := range []int{65, 1000, 12345, 100000} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			list := rand.New(rand.NewSource(int64(n))).Perm(n)
+			FunnelSort(list)
+			if !sort.IntsAreSorted(list) {
+				t.Fatalf("FunnelSort() did not sort %d values", n)
+			}
+		})
+	}
+}
+
+// BenchmarkSortLarge compares the merge sorts on arrays much larger than the
+// cache. The passes over the whole array that MergeSortTiled and FunnelSort
+// make are reported as a custom metric. MergeSortBottomUp2 always makes
+// log2(n) passes.
+// The 10^8 case needs a few GB of memory and is skipped with -short.
+func BenchmarkSortLarge(b *testing.B) {
+	implementations := []struct {
+		name   string
+		sort   func(a []int, elements *int)
+		passes bool
+	}{
+		{"MergeSortBottomUp2", func(a []int, _ *int) { MergeSortBottomUp2(a) }, false},
+		{"MergeSortTiled", tiledSort, true},
+		{"FunnelSort", funnelSort, true},
+	}
+	for _, n := range []int{1e6, 1e7, 1e8} {
+		if n > 1e7 && testing.Short() {
+			continue
+		}
+		list := rand.New(rand.NewSource(1)).Perm(n)
+		tosort := make([]int, n)
+		for _, impl := range implementations {
+			b.Run(fmt.Sprintf("%s_%d", impl.name, n), func(b *testing.B) {
+				passes := 0.0
+				for i := 0; i < b.N; i++ {
+					copy(tosort, list)
+					passes = countPasses(n, func(elements *int) { impl.sort(tosort, elements) })
+				}
+				if impl.passes {
+					b.ReportMetric(passes, "passes")
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package goalgorithms
+
+import "cmp"
+
+// AVLTree is an OrderedMap implemented as an AVL tree, a binary search tree
+// in which the heights of the two subtrees of every node differ by at most
+// one. A rotation or two after every change restore the balance, so the
+// height is less than 1.44*log2(n+2).
+// Put and Delete take O(log(n)) time, like all other operations but
+// iteration.
+type AVLTree[K, V any] struct {
+	bst[K, V]
+}
+
+// NewAVLTree returns an empty AVLTree with keys ordered like cmp.Compare does.
+func NewAVLTree[K cmp.Ordered, V any]() *AVLTree[K, V] {
+	return NewAVLTreeFunc[K, V](cmp.Compare[K])
+}
+
+// NewAVLTreeFunc returns an empty AVLTree with keys ordered by cmp, which
+// must return a negative number if a < b, zero if a == b and a positive
+// number if a > b.
+func NewAVLTreeFunc[K, V any](cmp func(a, b K) int) *AVLTree[K, V] {
+	return &AVLTree[K, V]{bst[K, V]{cmp: cmp}}
+}
+
+// Put sets the value of key, adding key if it is not in the map.
+func (t *AVLTree[K, V]) Put(key K, value V) {
+	t.root = t.put(t.root, key, value)
+}
+
+func (t *AVLTree[K, V]) put(n *node[K, V], key K, value V) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, size: 1, height: 1}
+	}
+	c := t.cmp(key, n.key)
+	if c == 0 {
+		n.value = value
+		return n
+	}
+	if c < 0 {
+		n.left = t.put(n.left, key, value)
+	} else {
+		n.right = t.put(n.right, key, value)
+	}
+	return avlBalance(n)
+}
+
+// Delete removes key from the map, and reports whether it was in the map.
+func (t *AVLTree[K, V]) Delete(key K) bool {
+	deleted := false
+	t.root = t.delete(t.root, key, &deleted)
+	return deleted
+}
+
+func (t *AVLTree[K, V]) delete(n *node[K, V], key K, deleted *bool) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	c := t.cmp(key, n.key)
+	if c < 0 {
+		n.left = t.delete(n.left, key, deleted)
+	} else if c > 0 {
+		n.right = t.delete(n.right, key, deleted)
+	} else {
+		*deleted = true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		// Replace n with its successor, the smallest node on the right.
+		m := minNode(n.right)
+		m.right = avlDeleteMin(n.right)
+		m.left = n.left
+		n = m
+	}
+	return avlBalance(n)
+}
+
+// avlDeleteMin removes the smallest node of the subtree of n, and returns the
+// new root of the subtree.
+func avlDeleteMin[K, V any](n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = avlDeleteMin(n.left)
+	return avlBalance(n)
+}
+
+// avlBalance updates n after one of its subtrees changed height by at most
+// one, rotating it if the heights of its subtrees differ by two, and returns
+// the new root of the subtree.
+func avlBalance[K, V any](n *node[K, V]) *node[K, V] {
+	n.update()
+	switch balance := height(n.left) - height(n.right); {
+	case balance > 1:
+		// If the left subtree leans right, a single rotation would just move
+		// the imbalance to the other side.
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case balance < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
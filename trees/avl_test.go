@@ -0,0 +1,49 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"testing"
+)
+
+// checkAVL returns an error if t is not a binary search tree, the height of a
+// node is wrong or the heights of its subtrees differ by more than one.
+func checkAVL[K, V any](t *bst[K, V]) error {
+	if err := checkBST(t); err != nil {
+		return err
+	}
+	var visit func(n *node[K, V]) error
+	visit = func(n *node[K, V]) error {
+		if n == nil {
+			return nil
+		}
+		if err := visit(n.left); err != nil {
+			return err
+		}
+		if err := visit(n.right); err != nil {
+			return err
+		}
+		if h := max(height(n.left), height(n.right)) + 1; n.height != h {
+			return fmt.Errorf("node %v has height %d, want %d", n.key, n.height, h)
+		}
+		if d := height(n.left) - height(n.right); d < -1 || d > 1 {
+			return fmt.Errorf("subtrees of node %v differ in height by %d", n.key, d)
+		}
+		return nil
+	}
+	return visit(t.root)
+}
+
+func TestAVLTree_Height(t *testing.T) {
+	// Sorted keys make an unbalanced tree degenerate into a list.
+	m := NewAVLTree[int, int]()
+	for i := 0; i < 1<<16; i++ {
+		m.Put(i, i)
+	}
+	// The height of an AVL tree with 2^16 nodes is less than 1.44*17.
+	if h := height(m.root); h > 24 {
+		t.Fatalf("height of AVL tree of %d sorted keys is %d, want at most 24", m.Len(), h)
+	}
+	if err := checkAVL(&m.bst); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,279 @@
+package goalgorithms
+
+import "iter"
+
+// OrderedMap is a map that keeps its keys sorted, so that besides looking up
+// keys it can find the keys nearest to a key, the rank of a key and the key
+// of a rank, and iterate over a range of keys in order.
+// Modifying a map while iterating over it is not allowed.
+type OrderedMap[K, V any] interface {
+	// Len returns the number of keys in the map.
+	Len() int
+	// Put sets the value of key, adding key if it is not in the map.
+	Put(key K, value V)
+	// Get returns the value of key, and whether key is in the map.
+	Get(key K) (V, bool)
+	// Delete removes key from the map, and reports whether it was in the map.
+	Delete(key K) bool
+	// Min returns the smallest key and its value, or false if the map is empty.
+	Min() (K, V, bool)
+	// Max returns the largest key and its value, or false if the map is empty.
+	Max() (K, V, bool)
+	// Floor returns the largest key that is not greater than key, and its
+	// value, or false if there is none.
+	Floor(key K) (K, V, bool)
+	// Ceiling returns the smallest key that is not smaller than key, and its
+	// value, or false if there is none.
+	Ceiling(key K) (K, V, bool)
+	// Rank returns the number of keys that are smaller than key.
+	Rank(key K) int
+	// Select returns the key of rank i, which is the i-th smallest key,
+	// counting from 0, and its value, or false if i is not in [0, Len()).
+	Select(i int) (K, V, bool)
+	// All returns an iterator over the keys and values of the map, in
+	// ascending order of the keys.
+	All() iter.Seq2[K, V]
+	// Range returns an iterator over the keys in [lo, hi) and their values,
+	// in ascending order of the keys.
+	Range(lo, hi K) iter.Seq2[K, V]
+}
+
+// node is a node of a binary search tree. Keys in the left subtree are
+// smaller than key, and keys in the right subtree are greater.
+type node[K, V any] struct {
+	key         K
+	value       V
+	left, right *node[K, V]
+	// size is the number of nodes in the subtree, used to find ranks.
+	size int
+	// height is the height of the subtree, which an AVLTree keeps balanced.
+	height int
+	// red is true in a RedBlackTree if the link from the parent is red.
+	red bool
+}
+
+func size[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func height[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the size and height of n from its children.
+func (n *node[K, V]) update() {
+	n.size = size(n.left) + 1 + size(n.right)
+	n.height = max(height(n.left), height(n.right)) + 1
+}
+
+// rotateLeft makes the right child of n the root of the subtree, with n as
+// its left child, and returns it.
+func rotateLeft[K, V any](n *node[K, V]) *node[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	n.update()
+	x.update()
+	return x
+}
+
+// rotateRight makes the left child of n the root of the subtree, with n as
+// its right child, and returns it.
+func rotateRight[K, V any](n *node[K, V]) *node[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	n.update()
+	x.update()
+	return x
+}
+
+func minNode[K, V any](n *node[K, V]) *node[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func maxNode[K, V any](n *node[K, V]) *node[K, V] {
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// entry returns the key and value of n, and false if n is nil.
+func entry[K, V any](n *node[K, V]) (key K, value V, ok bool) {
+	if n == nil {
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
+
+// bst implements the methods of OrderedMap that do not modify the tree, which
+// are the same for all balanced binary search trees. All of them take O(h)
+// time, where h is the height of the tree, except for iteration.
+type bst[K, V any] struct {
+	root *node[K, V]
+	cmp  func(a, b K) int
+}
+
+// Len returns the number of keys in the map.
+func (t *bst[K, V]) Len() int {
+	return size(t.root)
+}
+
+// Get returns the value of key, and whether key is in the map.
+func (t *bst[K, V]) Get(key K) (V, bool) {
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		if c == 0 {
+			return n.value, true
+		}
+		if c < 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Min returns the smallest key and its value, or false if the map is empty.
+func (t *bst[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		return entry[K, V](nil)
+	}
+	return entry(minNode(t.root))
+}
+
+// Max returns the largest key and its value, or false if the map is empty.
+func (t *bst[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		return entry[K, V](nil)
+	}
+	return entry(maxNode(t.root))
+}
+
+// Floor returns the largest key that is not greater than key, and its value,
+// or false if there is none.
+func (t *bst[K, V]) Floor(key K) (K, V, bool) {
+	var floor *node[K, V]
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		if c == 0 {
+			return entry(n)
+		}
+		if c < 0 {
+			n = n.left
+		} else {
+			floor = n
+			n = n.right
+		}
+	}
+	return entry(floor)
+}
+
+// Ceiling returns the smallest key that is not smaller than key, and its
+// value, or false if there is none.
+func (t *bst[K, V]) Ceiling(key K) (K, V, bool) {
+	var ceiling *node[K, V]
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		if c == 0 {
+			return entry(n)
+		}
+		if c > 0 {
+			n = n.right
+		} else {
+			ceiling = n
+			n = n.left
+		}
+	}
+	return entry(ceiling)
+}
+
+// Rank returns the number of keys that are smaller than key.
+func (t *bst[K, V]) Rank(key K) int {
+	rank := 0
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		if c <= 0 {
+			if c == 0 {
+				return rank + size(n.left)
+			}
+			n = n.left
+		} else {
+			rank += size(n.left) + 1
+			n = n.right
+		}
+	}
+	return rank
+}
+
+// Select returns the key of rank i and its value, or false if i is not in
+// [0, Len()).
+func (t *bst[K, V]) Select(i int) (K, V, bool) {
+	if i < 0 || i >= t.Len() {
+		return entry[K, V](nil)
+	}
+	n := t.root
+	for {
+		l := size(n.left)
+		if i == l {
+			return entry(n)
+		}
+		if i < l {
+			n = n.left
+		} else {
+			i -= l + 1
+			n = n.right
+		}
+	}
+}
+
+// All returns an iterator over the keys and values of the map, in ascending
+// order of the keys.
+func (t *bst[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.walk(t.root, nil, nil, yield)
+	}
+}
+
+// Range returns an iterator over the keys in [lo, hi) and their values, in
+// ascending order of the keys. Takes O(h+k) time, where k is the number of
+// keys in the range.
+func (t *bst[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.walk(t.root, &lo, &hi, yield)
+	}
+}
+
+// walk calls yield for the nodes of the subtree of n with keys in [lo, hi)
+// in order, skipping the subtrees that are out of range. A nil bound is
+// unbounded. It returns false if yield did.
+func (t *bst[K, V]) walk(n *node[K, V], lo, hi *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := lo == nil || t.cmp(n.key, *lo) >= 0
+	belowHi := hi == nil || t.cmp(n.key, *hi) < 0
+	if aboveLo && !t.walk(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	return !belowHi || t.walk(n.right, lo, hi, yield)
+}
@@ -0,0 +1,298 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// orderedMaps lists the implementations of OrderedMap, with functions that
+// return an error if the invariants of their trees do not hold.
+var orderedMaps = []struct {
+	name  string
+	new   func() OrderedMap[int, int]
+	check func(m OrderedMap[int, int]) error
+}{
+	{
+		"AVLTree",
+		func() OrderedMap[int, int] { return NewAVLTree[int, int]() },
+		func(m OrderedMap[int, int]) error { return checkAVL(&m.(*AVLTree[int, int]).bst) },
+	},
+	{
+		"RedBlackTree",
+		func() OrderedMap[int, int] { return NewRedBlackTree[int, int]() },
+		func(m OrderedMap[int, int]) error { return checkRedBlack(&m.(*RedBlackTree[int, int]).bst) },
+	},
+}
+
+// checkBST returns an error if the keys of t are not in ascending order, or
+// the size of a node is wrong.
+func checkBST[K, V any](t *bst[K, V]) error {
+	var prev *node[K, V]
+	var visit func(n *node[K, V]) error
+	visit = func(n *node[K, V]) error {
+		if n == nil {
+			return nil
+		}
+		if err := visit(n.left); err != nil {
+			return err
+		}
+		if prev != nil && t.cmp(prev.key, n.key) >= 0 {
+			return fmt.Errorf("key %v is not greater than the previous key %v", n.key, prev.key)
+		}
+		prev = n
+		if err := visit(n.right); err != nil {
+			return err
+		}
+		if n.size != size(n.left)+1+size(n.right) {
+			return fmt.Errorf("node %v has size %d, want %d", n.key, n.size, size(n.left)+1+size(n.right))
+		}
+		return nil
+	}
+	return visit(t.root)
+}
+
+// naiveMap is a sorted slice of keys with a map of values, which the trees
+// are compared with.
+type naiveMap struct {
+	keys   []int
+	values map[int]int
+}
+
+func (m *naiveMap) put(key, value int) {
+	if _, ok := m.values[key]; !ok {
+		i, _ := slices.BinarySearch(m.keys, key)
+		m.keys = slices.Insert(m.keys, i, key)
+	}
+	m.values[key] = value
+}
+
+func (m *naiveMap) delete(key int) bool {
+	i, ok := slices.BinarySearch(m.keys, key)
+	if ok {
+		m.keys = slices.Delete(m.keys, i, i+1)
+		delete(m.values, key)
+	}
+	return ok
+}
+
+// collect returns the keys and values of an iterator.
+func collect(seq func(yield func(int, int) bool)) (keys, values []int) {
+	for k, v := range seq {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	return keys, values
+}
+
+func TestOrderedMap(t *testing.T) {
+	for _, impl := range orderedMaps {
+		t.Run(impl.name, func(t *testing.T) {
+			m := impl.new()
+			if _, _, ok := m.Min(); ok {
+				t.Errorf("Min() of empty map returned true")
+			}
+			if _, _, ok := m.Max(); ok {
+				t.Errorf("Max() of empty map returned true")
+			}
+			if m.Delete(1) {
+				t.Errorf("Delete(1) on empty map returned true")
+			}
+			for _, k := range []int{50, 20, 80, 10, 30, 70, 90, 30} {
+				m.Put(k, k*10)
+			}
+			m.Put(30, 31)
+			if got := m.Len(); got != 7 {
+				t.Errorf("Len() = %d, want 7", got)
+			}
+			if v, ok := m.Get(30); !ok || v != 31 {
+				t.Errorf("Get(30) = %v, %v, want 31, true", v, ok)
+			}
+			if _, ok := m.Get(40); ok {
+				t.Errorf("Get(40) returned true")
+			}
+			if k, _, _ := m.Min(); k != 10 {
+				t.Errorf("Min() = %v, want 10", k)
+			}
+			if k, _, _ := m.Max(); k != 90 {
+				t.Errorf("Max() = %v, want 90", k)
+			}
+			if k, v, ok := m.Floor(45); k != 30 || v != 31 || !ok {
+				t.Errorf("Floor(45) = %v, %v, %v, want 30, 31, true", k, v, ok)
+			}
+			if _, _, ok := m.Floor(5); ok {
+				t.Errorf("Floor(5) returned true")
+			}
+			if k, _, ok := m.Ceiling(45); k != 50 || !ok {
+				t.Errorf("Ceiling(45) = %v, %v, want 50, true", k, ok)
+			}
+			if k, _, ok := m.Ceiling(50); k != 50 || !ok {
+				t.Errorf("Ceiling(50) = %v, %v, want 50, true", k, ok)
+			}
+			if _, _, ok := m.Ceiling(95); ok {
+				t.Errorf("Ceiling(95) returned true")
+			}
+			if got := m.Rank(50); got != 3 {
+				t.Errorf("Rank(50) = %v, want 3", got)
+			}
+			if got := m.Rank(55); got != 4 {
+				t.Errorf("Rank(55) = %v, want 4", got)
+			}
+			if k, _, ok := m.Select(4); k != 70 || !ok {
+				t.Errorf("Select(4) = %v, %v, want 70, true", k, ok)
+			}
+			if _, _, ok := m.Select(7); ok {
+				t.Errorf("Select(7) returned true")
+			}
+			if keys, _ := collect(m.Range(20, 70)); !reflect.DeepEqual(keys, []int{20, 30, 50}) {
+				t.Errorf("Range(20, 70) = %v, want [20 30 50]", keys)
+			}
+			if keys, values := collect(m.All()); !reflect.DeepEqual(keys, []int{10, 20, 30, 50, 70, 80, 90}) || values[2] != 31 {
+				t.Errorf("All() = %v, %v", keys, values)
+			}
+			for k := range m.All() {
+				if k == 30 {
+					break
+				}
+			}
+			if !m.Delete(50) || m.Delete(50) {
+				t.Errorf("Delete(50) twice did not return true, false")
+			}
+			if err := impl.check(m); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestOrderedMapFunc(t *testing.T) {
+	byLength := func(a, b string) int {
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+		return strings.Compare(a, b)
+	}
+	maps := []OrderedMap[string, int]{NewAVLTreeFunc[string, int](byLength), NewRedBlackTreeFunc[string, int](byLength)}
+	for _, m := range maps {
+		for i, w := range []string{"ccc", "a", "bb", "aa", "b"} {
+			m.Put(w, i)
+		}
+		var keys []string
+		for k := range m.All() {
+			keys = append(keys, k)
+		}
+		if want := []string{"a", "b", "aa", "bb", "ccc"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("%T keys = %v, want %v", m, keys, want)
+		}
+	}
+}
+
+// TestOrderedMap_Random applies random operations to the maps, comparing
+// them with a naive map, and checks the invariants of the trees after every
+// operation that modifies them.
+func TestOrderedMap_Random(t *testing.T) {
+	for _, impl := range orderedMaps {
+		t.Run(impl.name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(1))
+			m := impl.new()
+			want := &naiveMap{values: map[int]int{}}
+			for i := 0; i < 20000; i++ {
+				// Grow the map in the first half and shrink it in the second.
+				key := rnd.Intn(500)
+				putChance := 60
+				if i >= 10000 {
+					putChance = 35
+				}
+				switch op := rnd.Intn(100); {
+				case op < putChance:
+					m.Put(key, i)
+					want.put(key, i)
+				case op < 70:
+					if got, ok := m.Delete(key), want.delete(key); got != ok {
+						t.Fatalf("Delete(%d) = %v, want %v", key, got, ok)
+					}
+				default:
+					checkQueries(t, m, want, key)
+					continue
+				}
+				if err := impl.check(m); err != nil {
+					t.Fatalf("after %d operations: %v", i+1, err)
+				}
+				if m.Len() != len(want.keys) {
+					t.Fatalf("Len() = %d, want %d", m.Len(), len(want.keys))
+				}
+			}
+			keys, _ := collect(m.All())
+			if !slices.Equal(keys, want.keys) {
+				t.Fatalf("All() = %v, want %v", keys, want.keys)
+			}
+		})
+	}
+}
+
+// checkQueries compares the answers of m to the queries about key with those
+// of the naive map.
+func checkQueries(t *testing.T, m OrderedMap[int, int], want *naiveMap, key int) {
+	t.Helper()
+	i, found := slices.BinarySearch(want.keys, key)
+	if v, ok := m.Get(key); ok != found || found && v != want.values[key] {
+		t.Fatalf("Get(%d) = %v, %v, want %v, %v", key, v, ok, want.values[key], found)
+	}
+	if got := m.Rank(key); got != i {
+		t.Fatalf("Rank(%d) = %v, want %v", key, got, i)
+	}
+	// The expected floor is the key itself, or the one before its insertion
+	// point.
+	floor := i - 1
+	if found {
+		floor = i
+	}
+	if k, _, ok := m.Floor(key); ok != (floor >= 0) || ok && k != want.keys[floor] {
+		t.Fatalf("Floor(%d) = %v, %v", key, k, ok)
+	}
+	if k, _, ok := m.Ceiling(key); ok != (i < len(want.keys)) || ok && k != want.keys[i] {
+		t.Fatalf("Ceiling(%d) = %v, %v", key, k, ok)
+	}
+	if k, _, ok := m.Select(key % 200); ok != (key%200 < len(want.keys)) || ok && k != want.keys[key%200] {
+		t.Fatalf("Select(%d) = %v, %v", key%200, k, ok)
+	}
+	hi := key + 50
+	j, _ := slices.BinarySearch(want.keys, hi)
+	if keys, _ := collect(m.Range(key, hi)); !slices.Equal(keys, want.keys[i:j]) {
+		t.Fatalf("Range(%d, %d) = %v, want %v", key, hi, keys, want.keys[i:j])
+	}
+	if k, _, ok := m.Min(); ok != (len(want.keys) > 0) || ok && k != want.keys[0] {
+		t.Fatalf("Min() = %v, %v", k, ok)
+	}
+	if k, _, ok := m.Max(); ok != (len(want.keys) > 0) || ok && k != want.keys[len(want.keys)-1] {
+		t.Fatalf("Max() = %v, %v", k, ok)
+	}
+}
+
+func BenchmarkOrderedMap_Put(b *testing.B) {
+	for _, n := range []int{1000, 100000} {
+		keys := rand.New(rand.NewSource(1)).Perm(n)
+		b.Run(fmt.Sprintf("SortedSlice_%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var sorted []int
+				for _, k := range keys {
+					j, _ := slices.BinarySearch(sorted, k)
+					sorted = slices.Insert(sorted, j, k)
+				}
+			}
+		})
+		for _, impl := range orderedMaps {
+			b.Run(fmt.Sprintf("%s_%d", impl.name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					m := impl.new()
+					for _, k := range keys {
+						m.Put(k, k)
+					}
+				}
+			})
+		}
+	}
+}
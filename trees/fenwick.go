@@ -0,0 +1,106 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// FenwickTree, or binary indexed tree, holds an array of integers and answers
+// queries for the sums of its prefixes while the array is being changed.
+// tree[i] holds the sum of the elements in (i-lowbit(i), i], where lowbit(i)
+// is the lowest set bit of i, so every prefix sum is the sum of at most
+// log(n) nodes, and every element is in at most log(n) nodes.
+// Add, PrefixSum and LowerBound take O(log(n)) time.
+type FenwickTree struct {
+	// tree is 1-based, tree[0] is not used.
+	tree []int
+}
+
+// NewFenwickTree returns a FenwickTree of n zeros.
+func NewFenwickTree(n int) *FenwickTree {
+	return &FenwickTree{tree: make([]int, n+1)}
+}
+
+// NewFenwickTreeFromArray returns a FenwickTree of the given values. Takes
+// O(n) time, by adding every node to its parent once it is complete.
+func NewFenwickTreeFromArray(values ...int) *FenwickTree {
+	tree := make([]int, len(values)+1)
+	copy(tree[1:], values)
+	for i := 1; i < len(tree); i++ {
+		if parent := i + i&-i; parent < len(tree) {
+			tree[parent] += tree[i]
+		}
+	}
+	return &FenwickTree{tree: tree}
+}
+
+// Len returns the number of elements in the array.
+func (t *FenwickTree) Len() int {
+	return len(t.tree) - 1
+}
+
+// checkIndex panics like a slice index would, if i is not in [0, n).
+func checkIndex(i, n int) {
+	if i < 0 || i >= n {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", i, n))
+	}
+}
+
+// checkRange panics like slicing would, if [lo, hi) is not a range of
+// [0, n).
+func checkRange(lo, hi, n int) {
+	if lo < 0 || hi > n || lo > hi {
+		panic(fmt.Sprintf("slice bounds out of range [%d:%d] with length %d", lo, hi, n))
+	}
+}
+
+// Add adds delta to the element at index i. It panics if i is out of range.
+func (t *FenwickTree) Add(i, delta int) {
+	checkIndex(i, t.Len())
+	for i++; i < len(t.tree); i += i & -i {
+		t.tree[i] += delta
+	}
+}
+
+// PrefixSum returns the sum of the first n elements, the ones before index n.
+// It panics if n is negative or greater than Len().
+func (t *FenwickTree) PrefixSum(n int) int {
+	checkRange(0, n, t.Len())
+	sum := 0
+	for ; n > 0; n -= n & -n {
+		sum += t.tree[n]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of the elements in [lo, hi). It panics if the
+// range is not within [0, Len()) or lo > hi.
+func (t *FenwickTree) RangeSum(lo, hi int) int {
+	checkRange(lo, hi, t.Len())
+	return t.PrefixSum(hi) - t.PrefixSum(lo)
+}
+
+// Get returns the element at index i. It panics if i is out of range.
+func (t *FenwickTree) Get(i int) int {
+	checkIndex(i, t.Len())
+	return t.RangeSum(i, i+1)
+}
+
+// LowerBound returns the smallest index i for which the sum of the elements
+// up to and including index i is at least x, or Len() if there is none. The
+// elements must not be negative, so that the prefix sums are sorted.
+// Instead of a binary search with PrefixSum, which would take O(log²(n))
+// time, it descends the implicit tree, trying the nodes of decreasing powers
+// of two.
+func (t *FenwickTree) LowerBound(x int) int {
+	// pos is the length of the longest prefix found so far whose sum is
+	// smaller than x, and x is reduced by its sum.
+	pos := 0
+	for step := 1 << bits.Len(uint(t.Len())) >> 1; step > 0; step >>= 1 {
+		if next := pos + step; next < len(t.tree) && t.tree[next] < x {
+			pos = next
+			x -= t.tree[next]
+		}
+	}
+	return pos
+}
@@ -0,0 +1,148 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestFenwickTree(t *testing.T) {
+	ft := NewFenwickTreeFromArray(3, 0, 2, 5, 1)
+	if got := ft.Len(); got != 5 {
+		t.Errorf("Len() = %d, want 5", got)
+	}
+	sums := []int{0, 3, 3, 5, 10, 11}
+	for n, want := range sums {
+		if got := ft.PrefixSum(n); got != want {
+			t.Errorf("PrefixSum(%d) = %d, want %d", n, got, want)
+		}
+	}
+	if got := ft.RangeSum(1, 4); got != 7 {
+		t.Errorf("RangeSum(1, 4) = %d, want 7", got)
+	}
+	tests := []struct {
+		x    int
+		want int
+	}{
+		{-1, 0},
+		{0, 0},
+		{1, 0},
+		{3, 0},
+		{4, 2},
+		{5, 2},
+		{6, 3},
+		{11, 4},
+		{12, 5},
+	}
+	for _, tt := range tests {
+		if got := ft.LowerBound(tt.x); got != tt.want {
+			t.Errorf("LowerBound(%d) = %d, want %d", tt.x, got, tt.want)
+		}
+	}
+	ft.Add(1, 4)
+	if got := ft.Get(1); got != 4 {
+		t.Errorf("Get(1) after Add(1, 4) = %d, want 4", got)
+	}
+	if got := ft.LowerBound(5); got != 1 {
+		t.Errorf("LowerBound(5) after Add(1, 4) = %d, want 1", got)
+	}
+	if got := NewFenwickTree(0).LowerBound(1); got != 0 {
+		t.Errorf("LowerBound(1) of empty tree = %d, want 0", got)
+	}
+}
+
+// checkPanics reports an error if f does not panic with a message that
+// contains want.
+func checkPanics(t *testing.T, name, want string, f func()) {
+	t.Helper()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("%s did not panic", name)
+		} else if msg := fmt.Sprint(r); !strings.Contains(msg, want) {
+			t.Errorf("%s panicked with %q, want %q", name, msg, want)
+		}
+	}()
+	f()
+}
+
+// badRanges are ranges that are out of bounds of 3 elements, or reversed.
+var badRanges = []struct {
+	lo, hi int
+}{
+	{-1, 2},
+	{-3, -1},
+	{1, 4},
+	{0, 100},
+	{2, 1},
+}
+
+func TestFenwickTree_IndexOutOfRange(t *testing.T) {
+	tests := []struct {
+		n, i int
+	}{
+		{3, -1},
+		{3, 3},
+		{3, 5},
+		{0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("n=%d/i=%d", tt.n, tt.i), func(t *testing.T) {
+			ft := NewFenwickTreeFromArray(make([]int, tt.n)...)
+			want := fmt.Sprintf("[%d]", tt.i)
+			checkPanics(t, fmt.Sprintf("Add(%d, 1)", tt.i), want, func() { ft.Add(tt.i, 1) })
+			checkPanics(t, fmt.Sprintf("Get(%d)", tt.i), want, func() { ft.Get(tt.i) })
+			if got := ft.PrefixSum(tt.n); got != 0 {
+				t.Errorf("PrefixSum(%d) = %d after Add out of range, want 0", tt.n, got)
+			}
+		})
+	}
+	ft := NewFenwickTree(3)
+	for _, r := range badRanges {
+		want := fmt.Sprintf("[%d:%d]", r.lo, r.hi)
+		checkPanics(t, fmt.Sprintf("RangeSum(%d, %d)", r.lo, r.hi), want, func() { ft.RangeSum(r.lo, r.hi) })
+	}
+	for _, n := range []int{-1, 4} {
+		checkPanics(t, fmt.Sprintf("PrefixSum(%d)", n), fmt.Sprintf("[0:%d]", n), func() { ft.PrefixSum(n) })
+	}
+}
+
+// TestFenwickTree_Random applies random additions to trees of several sizes,
+// and compares their answers with sums computed by naive loops.
+func TestFenwickTree_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 7, 16, 100} {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = rnd.Intn(10)
+		}
+		ft := NewFenwickTreeFromArray(values...)
+		for op := 0; op < 2000; op++ {
+			i := rnd.Intn(n)
+			// Keep the values non-negative for LowerBound.
+			delta := rnd.Intn(10) - values[i]/2
+			ft.Add(i, delta)
+			values[i] += delta
+
+			lo := rnd.Intn(n + 1)
+			hi := lo + rnd.Intn(n+1-lo)
+			want := 0
+			for _, v := range values[lo:hi] {
+				want += v
+			}
+			if got := ft.RangeSum(lo, hi); got != want {
+				t.Fatalf("n=%d: RangeSum(%d, %d) = %d, want %d", n, lo, hi, got, want)
+			}
+			x := rnd.Intn(5*n + 10)
+			wantIndex, sum := 0, 0
+			for ; wantIndex < n; wantIndex++ {
+				if sum += values[wantIndex]; sum >= x {
+					break
+				}
+			}
+			if got := ft.LowerBound(x); got != wantIndex {
+				t.Fatalf("n=%d: LowerBound(%d) = %d, want %d", n, x, got, wantIndex)
+			}
+		}
+	}
+}
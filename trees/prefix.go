@@ -0,0 +1,24 @@
+package goalgorithms
+
+import "iter"
+
+// PrefixMap is a map with string keys that finds keys by their prefixes, like
+// for autocompletion, and the longest key that is a prefix of a string, like
+// for routing tables. Keys are compared byte by byte.
+// Modifying a map while iterating over it is not allowed.
+type PrefixMap[V any] interface {
+	// Len returns the number of keys in the map.
+	Len() int
+	// Insert sets the value of key, adding key if it is not in the map.
+	Insert(key string, value V)
+	// Get returns the value of key, and whether key is in the map.
+	Get(key string) (V, bool)
+	// Delete removes key from the map, and reports whether it was in the map.
+	Delete(key string) bool
+	// PrefixIterate returns an iterator over the keys that start with prefix
+	// and their values, in ascending order of the keys.
+	PrefixIterate(prefix string) iter.Seq2[string, V]
+	// LongestPrefixOf returns the longest key that is a prefix of s, and its
+	// value, or false if there is none.
+	LongestPrefixOf(s string) (string, V, bool)
+}
@@ -0,0 +1,368 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	search "github.com/quasoft/goalgorithms/search"
+)
+
+// prefixMaps lists the implementations of PrefixMap.
+var prefixMaps = []struct {
+	name string
+	new  func() PrefixMap[int]
+}{
+	{"Trie", func() PrefixMap[int] { return NewTrie[int]() }},
+	{"RadixTree", func() PrefixMap[int] { return NewRadixTree[int]() }},
+	{"TernarySearchTree", func() PrefixMap[int] { return NewTernarySearchTree[int]() }},
+}
+
+// checkPrefixMap returns an error if the invariants of the tree of m do not
+// hold.
+func checkPrefixMap(m PrefixMap[int]) error {
+	switch m := m.(type) {
+	case *Trie[int]:
+		return checkTrie(&m.root, "")
+	case *RadixTree[int]:
+		return checkRadix(&m.root, "")
+	case *TernarySearchTree[int]:
+		return checkTST(m.root, "")
+	}
+	return fmt.Errorf("unknown prefix map %T", m)
+}
+
+// checkTrie returns an error if the labels of a node are not in ascending
+// order, or a node below the root leads to no key.
+func checkTrie(n *trieNode[int], prefix string) error {
+	if prefix != "" && !n.hasValue && len(n.children) == 0 {
+		return fmt.Errorf("node %q leads to no key", prefix)
+	}
+	for i, child := range n.children {
+		if i > 0 && n.labels[i-1] >= n.labels[i] {
+			return fmt.Errorf("labels of node %q are not in ascending order: %q", prefix, n.labels)
+		}
+		if err := checkTrie(child, prefix+string(n.labels[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRadix returns an error if the labels of a node are empty or not in
+// ascending order of their first bytes, or a node below the root has no
+// value and less than two children.
+func checkRadix(n *radixNode[int], prefix string) error {
+	if prefix != "" && !n.hasValue && len(n.children) < 2 {
+		return fmt.Errorf("node %q has no value and %d children", prefix, len(n.children))
+	}
+	for i, child := range n.children {
+		if n.labels[i] == "" {
+			return fmt.Errorf("node %q has an empty label", prefix)
+		}
+		if i > 0 && n.labels[i-1][0] >= n.labels[i][0] {
+			return fmt.Errorf("labels of node %q are not in ascending order: %q", prefix, n.labels)
+		}
+		if err := checkRadix(child, prefix+n.labels[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkTST returns an error if the bytes of the left and right subtrees of a
+// node are not smaller and greater than its own, or a node leads to no key.
+func checkTST(n *tstNode[int], prefix string) error {
+	var visit func(n *tstNode[int], lo, hi int) error
+	visit = func(n *tstNode[int], lo, hi int) error {
+		if n == nil {
+			return nil
+		}
+		if int(n.c) <= lo || int(n.c) >= hi {
+			return fmt.Errorf("byte %q after %q is out of order", n.c, prefix)
+		}
+		if !n.hasValue && n.mid == nil {
+			return fmt.Errorf("node %q leads to no key", prefix+string(n.c))
+		}
+		if err := checkTST(n.mid, prefix+string(n.c)); err != nil {
+			return err
+		}
+		if err := visit(n.left, lo, int(n.c)); err != nil {
+			return err
+		}
+		return visit(n.right, int(n.c), hi)
+	}
+	return visit(n, -1, 256)
+}
+
+// collectPrefix returns the keys and values of an iterator.
+func collectPrefix(seq func(yield func(string, int) bool)) (keys []string, values []int) {
+	for k, v := range seq {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	return keys, values
+}
+
+func TestPrefixMap(t *testing.T) {
+	for _, impl := range prefixMaps {
+		t.Run(impl.name, func(t *testing.T) {
+			m := impl.new()
+			if _, _, ok := m.LongestPrefixOf("test"); ok {
+				t.Errorf("LongestPrefixOf(\"test\") on empty map returned true")
+			}
+			if m.Delete("") {
+				t.Errorf("Delete(\"\") on empty map returned true")
+			}
+			words := []string{"team", "tea", "test", "ten", "to", "toast", "i", "in", "inn", "tea"}
+			for i, w := range words {
+				m.Insert(w, i)
+			}
+			if got := m.Len(); got != 9 {
+				t.Errorf("Len() = %d, want 9", got)
+			}
+			if v, ok := m.Get("tea"); !ok || v != 9 {
+				t.Errorf("Get(\"tea\") = %v, %v, want 9, true", v, ok)
+			}
+			for _, key := range []string{"", "t", "te", "teams", "x"} {
+				if _, ok := m.Get(key); ok {
+					t.Errorf("Get(%q) returned true", key)
+				}
+			}
+			tests := []struct {
+				prefix string
+				want   []string
+			}{
+				{"te", []string{"tea", "team", "ten", "test"}},
+				{"tea", []string{"tea", "team"}},
+				{"to", []string{"to", "toast"}},
+				{"toa", []string{"toast"}},
+				{"tox", nil},
+				{"", []string{"i", "in", "inn", "tea", "team", "ten", "test", "to", "toast"}},
+			}
+			for _, tt := range tests {
+				if keys, _ := collectPrefix(m.PrefixIterate(tt.prefix)); !slices.Equal(keys, tt.want) {
+					t.Errorf("PrefixIterate(%q) = %q, want %q", tt.prefix, keys, tt.want)
+				}
+			}
+			for k := range m.PrefixIterate("te") {
+				if k == "team" {
+					break
+				}
+			}
+			longest := []struct {
+				s, want string
+				ok      bool
+			}{
+				{"teammate", "team", true},
+				{"teas", "tea", true},
+				{"toasted", "toast", true},
+				{"inner", "inn", true},
+				{"t", "", false},
+				{"", "", false},
+			}
+			for _, tt := range longest {
+				if k, _, ok := m.LongestPrefixOf(tt.s); k != tt.want || ok != tt.ok {
+					t.Errorf("LongestPrefixOf(%q) = %q, %v, want %q, %v", tt.s, k, ok, tt.want, tt.ok)
+				}
+			}
+			m.Insert("", -1)
+			if k, v, ok := m.LongestPrefixOf("t"); k != "" || v != -1 || !ok {
+				t.Errorf("LongestPrefixOf(\"t\") = %q, %v, %v, want \"\", -1, true", k, v, ok)
+			}
+			if !m.Delete("tea") || m.Delete("tea") {
+				t.Errorf("Delete(\"tea\") twice did not return true, false")
+			}
+			if m.Delete("te") {
+				t.Errorf("Delete(\"te\") returned true")
+			}
+			if keys, _ := collectPrefix(m.PrefixIterate("te")); !slices.Equal(keys, []string{"team", "ten", "test"}) {
+				t.Errorf("PrefixIterate(\"te\") after Delete = %q", keys)
+			}
+			if !m.Delete("") || m.Len() != 8 {
+				t.Errorf("Delete(\"\") did not remove the empty key")
+			}
+			if err := checkPrefixMap(m); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// nestedWord returns a random word over a small alphabet and the lengths of
+// up to three of its prefixes, in random order, which may include the empty
+// prefix. Keys made of such prefixes nest, so they split and share the edges
+// of a radix tree.
+func nestedWord(rnd *rand.Rand) (string, []int) {
+	b := make([]byte, 1+rnd.Intn(10))
+	for i := range b {
+		b[i] = "abcd"[rnd.Intn(4)]
+	}
+	cuts := rnd.Perm(len(b) + 1)[:min(1+rnd.Intn(3), len(b)+1)]
+	return string(b), cuts
+}
+
+// TestPrefixMap_Random inserts words together with some of their prefixes and
+// deletes random keys, which makes the radix tree split and merge its edges.
+// Deleting absent keys must leave the map unchanged. After every change it
+// checks the tree and compares Get on every key, and the queries on the
+// prefixes of the word, with a sorted slice of the keys.
+func TestPrefixMap_Random(t *testing.T) {
+	for _, impl := range prefixMaps {
+		t.Run(impl.name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(1))
+			m := impl.new()
+			var keys []string
+			values := map[string]int{}
+			for i := 0; i < 2000; i++ {
+				word, cuts := nestedWord(rnd)
+				for _, c := range cuts {
+					key := word[:c]
+					if j, found := slices.BinarySearch(keys, key); !found {
+						keys = slices.Insert(keys, j, key)
+					}
+					m.Insert(key, i)
+					values[key] = i
+					checkPrefixQueries(t, m, keys, values, word)
+				}
+				// A random word is usually absent, and deleting it must
+				// change nothing.
+				miss, _ := nestedWord(rnd)
+				if _, found := slices.BinarySearch(keys, miss); !found {
+					if m.Delete(miss) {
+						t.Fatalf("Delete(%q) of an absent key returned true", miss)
+					}
+					checkPrefixQueries(t, m, keys, values, miss)
+				}
+				// Keep about 200 keys. Deleting a key in the middle of a
+				// chain of nested keys merges two edges of the radix tree.
+				for len(keys) > 200 {
+					j := rnd.Intn(len(keys))
+					key := keys[j]
+					keys = slices.Delete(keys, j, j+1)
+					delete(values, key)
+					if !m.Delete(key) || m.Delete(key) {
+						t.Fatalf("Delete(%q) twice did not return true, false", key)
+					}
+					checkPrefixQueries(t, m, keys, values, key)
+				}
+			}
+		})
+	}
+}
+
+// checkPrefixQueries checks the tree of m and compares Get on every key, and
+// Get, PrefixIterate and LongestPrefixOf on every prefix of word, with the
+// answers found in the sorted keys.
+func checkPrefixQueries(t *testing.T, m PrefixMap[int], keys []string, values map[string]int, word string) {
+	t.Helper()
+	if err := checkPrefixMap(m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Len() != len(keys) {
+		t.Fatalf("Len() = %d, want %d", m.Len(), len(keys))
+	}
+	for _, key := range keys {
+		if v, ok := m.Get(key); !ok || v != values[key] {
+			t.Fatalf("Get(%q) = %v, %v, want %v, true", key, v, ok, values[key])
+		}
+	}
+	longest, found := "", false
+	for n := 0; n <= len(word); n++ {
+		prefix := word[:n]
+		// The keys with the prefix form a run of the sorted keys.
+		i, ok := slices.BinarySearch(keys, prefix)
+		j := i
+		for j < len(keys) && strings.HasPrefix(keys[j], prefix) {
+			j++
+		}
+		got, gotValues := collectPrefix(m.PrefixIterate(prefix))
+		if !slices.Equal(got, keys[i:j]) {
+			t.Fatalf("PrefixIterate(%q) = %q, want %q", prefix, got, keys[i:j])
+		}
+		for k, key := range got {
+			if gotValues[k] != values[key] {
+				t.Fatalf("PrefixIterate(%q) value of %q = %v, want %v", prefix, key, gotValues[k], values[key])
+			}
+		}
+
+		if v, got := m.Get(prefix); got != ok || ok && v != values[prefix] {
+			t.Fatalf("Get(%q) = %v, %v, want %v, %v", prefix, v, got, values[prefix], ok)
+		}
+		if ok {
+			longest, found = prefix, true
+		}
+		if k, v, ok := m.LongestPrefixOf(prefix); k != longest || ok != found || ok && v != values[longest] {
+			t.Fatalf("LongestPrefixOf(%q) = %q, %v, %v, want %q, %v", prefix, k, v, ok, longest, found)
+		}
+	}
+}
+
+// prefixWords returns n distinct words in ascending order, made of the words
+// of nestedWord and their prefixes.
+func prefixWords(n int) []string {
+	rnd := rand.New(rand.NewSource(1))
+	seen := map[string]bool{}
+	for len(seen) < n {
+		word, cuts := nestedWord(rnd)
+		for _, c := range cuts {
+			seen[word[:c]] = true
+		}
+	}
+	words := make([]string, 0, len(seen))
+	for w := range seen {
+		words = append(words, w)
+	}
+	slices.Sort(words)
+	return words
+}
+
+// BenchmarkPrefixMap compares the maps with binary searches of a sorted
+// slice of the words. BinarySearchLinear only takes integers, so its generic
+// counterpart InsertionPointOrdered is used for the slice.
+func BenchmarkPrefixMap(b *testing.B) {
+	words := prefixWords(200000)
+	queries := slices.Clone(words)
+	rand.New(rand.NewSource(2)).Shuffle(len(queries), func(i, j int) {
+		queries[i], queries[j] = queries[j], queries[i]
+	})
+	prefixes := []string{"a", "cd", "bad", "dcba"}
+
+	b.Run("Get/SortedSlice", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, ok := search.InsertionPointOrdered(queries[i%len(queries)], words); !ok {
+				b.Fatal("word not found")
+			}
+		}
+	})
+	b.Run("PrefixIterate/SortedSlice", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			prefix := prefixes[i%len(prefixes)]
+			for j := search.LowerBoundOrdered(prefix, words); j < len(words) && strings.HasPrefix(words[j], prefix); j++ {
+			}
+		}
+	})
+	for _, impl := range prefixMaps {
+		// Inserting the words in random order keeps the ternary search tree
+		// balanced.
+		m := impl.new()
+		for i, w := range queries {
+			m.Insert(w, i)
+		}
+		b.Run("Get/"+impl.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, ok := m.Get(queries[i%len(queries)]); !ok {
+					b.Fatal("word not found")
+				}
+			}
+		})
+		b.Run("PrefixIterate/"+impl.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for range m.PrefixIterate(prefixes[i%len(prefixes)]) {
+				}
+			}
+		})
+	}
+}
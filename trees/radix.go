@@ -0,0 +1,216 @@
+package goalgorithms
+
+import (
+	"iter"
+	"slices"
+	"strings"
+)
+
+// RadixTree is a PrefixMap implemented as a radix tree, also known as a
+// Patricia tree. It is a Trie in which every chain of nodes with a single
+// child and no value is merged into one edge, labeled with a string, so it
+// has at most two nodes per key, and the keys are stored in fewer and larger
+// pieces. All operations take O(m) time, where m is the length of the key.
+type RadixTree[V any] struct {
+	root radixNode[V]
+	size int
+}
+
+// radixNode is a node of a RadixTree. The labels of the edges to its children
+// are not empty, start with different bytes and are in ascending order. Every
+// node but the root has a value or at least two children.
+type radixNode[V any] struct {
+	labels   []string
+	children []*radixNode[V]
+	value    V
+	hasValue bool
+}
+
+// NewRadixTree returns an empty RadixTree.
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{}
+}
+
+// edge returns the index of the edge of n that starts with byte c, and
+// whether there is one, or else the index where it would be inserted.
+func (n *radixNode[V]) edge(c byte) (int, bool) {
+	return slices.BinarySearchFunc(n.labels, c, func(label string, c byte) int {
+		return int(label[0]) - int(c)
+	})
+}
+
+// commonPrefix returns the length of the longest common prefix of a and b.
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// Len returns the number of keys in the map.
+func (t *RadixTree[V]) Len() int {
+	return t.size
+}
+
+// Insert sets the value of key, adding key if it is not in the map.
+func (t *RadixTree[V]) Insert(key string, value V) {
+	n := &t.root
+	for len(key) > 0 {
+		i, ok := n.edge(key[0])
+		if !ok {
+			n.labels = slices.Insert(n.labels, i, key)
+			n.children = slices.Insert(n.children, i, &radixNode[V]{value: value, hasValue: true})
+			t.size++
+			return
+		}
+		label := n.labels[i]
+		p := commonPrefix(label, key)
+		if p < len(label) {
+			// Split the edge at the end of the common prefix.
+			mid := &radixNode[V]{labels: []string{label[p:]}, children: []*radixNode[V]{n.children[i]}}
+			n.labels[i] = label[:p]
+			n.children[i] = mid
+		}
+		n = n.children[i]
+		key = key[p:]
+	}
+	if !n.hasValue {
+		t.size++
+	}
+	n.value, n.hasValue = value, true
+}
+
+// find returns the node at the end of the path that spells key, or, if key
+// ends inside the label of an edge, the node at its end and the rest of the
+// label. It returns nil if there is no such path.
+func (t *RadixTree[V]) find(key string) (*radixNode[V], string) {
+	n := &t.root
+	for len(key) > 0 {
+		i, ok := n.edge(key[0])
+		if !ok {
+			return nil, ""
+		}
+		label := n.labels[i]
+		if len(key) < len(label) {
+			if strings.HasPrefix(label, key) {
+				return n.children[i], label[len(key):]
+			}
+			return nil, ""
+		}
+		if key[:len(label)] != label {
+			return nil, ""
+		}
+		n = n.children[i]
+		key = key[len(label):]
+	}
+	return n, ""
+}
+
+// Get returns the value of key, and whether key is in the map.
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	if n, rest := t.find(key); n != nil && rest == "" && n.hasValue {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes key from the map, and reports whether it was in the map.
+// The node of key is removed if it has no children, and merged with its
+// child if it has one, as is its parent if it is left with a single child.
+func (t *RadixTree[V]) Delete(key string) bool {
+	// path holds the nodes on the way to key, and the edges taken from them.
+	type step struct {
+		n    *radixNode[V]
+		edge int
+	}
+	var path []step
+	n := &t.root
+	for rest := key; len(rest) > 0; {
+		i, ok := n.edge(rest[0])
+		if !ok || !strings.HasPrefix(rest, n.labels[i]) {
+			return false
+		}
+		path = append(path, step{n, i})
+		rest = rest[len(n.labels[i]):]
+		n = n.children[i]
+	}
+	if !n.hasValue {
+		return false
+	}
+	var zero V
+	n.value, n.hasValue = zero, false
+	t.size--
+	if len(path) == 0 {
+		return true
+	}
+	parent := path[len(path)-1]
+	switch len(n.children) {
+	case 0:
+		parent.n.labels = slices.Delete(parent.n.labels, parent.edge, parent.edge+1)
+		parent.n.children = slices.Delete(parent.n.children, parent.edge, parent.edge+1)
+		if len(path) > 1 && !parent.n.hasValue && len(parent.n.children) == 1 {
+			grand := path[len(path)-2]
+			grand.n.merge(grand.edge)
+		}
+	case 1:
+		parent.n.merge(parent.edge)
+	}
+	return true
+}
+
+// merge merges the child of n on edge i, which has a single child and no
+// value, with its child.
+func (n *radixNode[V]) merge(i int) {
+	child := n.children[i]
+	n.labels[i] += child.labels[0]
+	n.children[i] = child.children[0]
+}
+
+// PrefixIterate returns an iterator over the keys that start with prefix and
+// their values, in ascending order of the keys.
+func (t *RadixTree[V]) PrefixIterate(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		if n, rest := t.find(prefix); n != nil {
+			n.walk([]byte(prefix+rest), yield)
+		}
+	}
+}
+
+// walk calls yield for the keys in the subtree of n, whose prefix is key, in
+// order. It returns false if yield did.
+func (n *radixNode[V]) walk(key []byte, yield func(string, V) bool) bool {
+	if n.hasValue && !yield(string(key), n.value) {
+		return false
+	}
+	for i, child := range n.children {
+		if !child.walk(append(key, n.labels[i]...), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// LongestPrefixOf returns the longest key that is a prefix of s, and its
+// value, or false if there is none.
+func (t *RadixTree[V]) LongestPrefixOf(s string) (string, V, bool) {
+	var value V
+	length, found := 0, false
+	n := &t.root
+	for i := 0; ; {
+		if n.hasValue {
+			value, length, found = n.value, i, true
+		}
+		if i == len(s) {
+			break
+		}
+		e, ok := n.edge(s[i])
+		if !ok || !strings.HasPrefix(s[i:], n.labels[e]) {
+			break
+		}
+		i += len(n.labels[e])
+		n = n.children[e]
+	}
+	return s[:length], value, found
+}
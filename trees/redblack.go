@@ -0,0 +1,178 @@
+package goalgorithms
+
+import "cmp"
+
+// RedBlackTree is an OrderedMap implemented as a left-leaning red-black tree,
+// as described by Sedgewick. It encodes a 2-3 tree as a binary search tree,
+// in which a 3-node is a pair of nodes joined by a red link that leans left.
+// No node has two red links, and every path from the root to a leaf has the
+// same number of black links, so the height is at most 2*log2(n+1).
+// Put and Delete take O(log(n)) time, like all other operations but
+// iteration.
+type RedBlackTree[K, V any] struct {
+	bst[K, V]
+}
+
+// NewRedBlackTree returns an empty RedBlackTree with keys ordered like
+// cmp.Compare does.
+func NewRedBlackTree[K cmp.Ordered, V any]() *RedBlackTree[K, V] {
+	return NewRedBlackTreeFunc[K, V](cmp.Compare[K])
+}
+
+// NewRedBlackTreeFunc returns an empty RedBlackTree with keys ordered by cmp,
+// which must return a negative number if a < b, zero if a == b and a positive
+// number if a > b.
+func NewRedBlackTreeFunc[K, V any](cmp func(a, b K) int) *RedBlackTree[K, V] {
+	return &RedBlackTree[K, V]{bst[K, V]{cmp: cmp}}
+}
+
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+// rotateLeftRed rotates a red link that leans right to lean left.
+func rotateLeftRed[K, V any](n *node[K, V]) *node[K, V] {
+	x := rotateLeft(n)
+	x.red = n.red
+	n.red = true
+	return x
+}
+
+// rotateRightRed rotates a red link that leans left to lean right.
+func rotateRightRed[K, V any](n *node[K, V]) *node[K, V] {
+	x := rotateRight(n)
+	x.red = n.red
+	n.red = true
+	return x
+}
+
+// flipColors flips the colors of n and its children, which splits a
+// temporary 4-node, or joins the children into one.
+func flipColors[K, V any](n *node[K, V]) {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+// rbBalance restores the invariants on the way up from a change below n,
+// and returns the new root of the subtree.
+func rbBalance[K, V any](n *node[K, V]) *node[K, V] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = rotateLeftRed(n)
+	}
+	if isRed(n.left) && isRed(n.left.left) {
+		n = rotateRightRed(n)
+	}
+	if isRed(n.left) && isRed(n.right) {
+		flipColors(n)
+	}
+	n.update()
+	return n
+}
+
+// Put sets the value of key, adding key if it is not in the map.
+func (t *RedBlackTree[K, V]) Put(key K, value V) {
+	t.root = t.put(t.root, key, value)
+	t.root.red = false
+}
+
+func (t *RedBlackTree[K, V]) put(n *node[K, V], key K, value V) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, size: 1, height: 1, red: true}
+	}
+	c := t.cmp(key, n.key)
+	if c == 0 {
+		n.value = value
+		return n
+	}
+	if c < 0 {
+		n.left = t.put(n.left, key, value)
+	} else {
+		n.right = t.put(n.right, key, value)
+	}
+	return rbBalance(n)
+}
+
+// Delete removes key from the map, and reports whether it was in the map.
+func (t *RedBlackTree[K, V]) Delete(key K) bool {
+	if _, ok := t.Get(key); !ok {
+		return false
+	}
+	// Make the root red, so that there is a red link to carry down.
+	if !isRed(t.root.left) && !isRed(t.root.right) {
+		t.root.red = true
+	}
+	t.root = t.delete(t.root, key)
+	if t.root != nil {
+		t.root.red = false
+	}
+	return true
+}
+
+// delete removes key, which must be in the subtree of n, and returns the new
+// root of the subtree. On the way down, it makes sure that the current node
+// is not a 2-node, by borrowing from a sibling or joining with it, so that
+// the key can be removed from the bottom without breaking the balance.
+func (t *RedBlackTree[K, V]) delete(n *node[K, V], key K) *node[K, V] {
+	if t.cmp(key, n.key) < 0 {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = moveRedLeft(n)
+		}
+		n.left = t.delete(n.left, key)
+		return rbBalance(n)
+	}
+	if isRed(n.left) {
+		n = rotateRightRed(n)
+	}
+	if t.cmp(key, n.key) == 0 && n.right == nil {
+		return nil
+	}
+	if !isRed(n.right) && !isRed(n.right.left) {
+		n = moveRedRight(n)
+	}
+	if t.cmp(key, n.key) == 0 {
+		// Replace the key with its successor, and delete the successor.
+		m := minNode(n.right)
+		n.key, n.value = m.key, m.value
+		n.right = rbDeleteMin(n.right)
+	} else {
+		n.right = t.delete(n.right, key)
+	}
+	return rbBalance(n)
+}
+
+// rbDeleteMin removes the smallest node of the subtree of n, which is not a
+// 2-node, and returns the new root of the subtree.
+func rbDeleteMin[K, V any](n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return nil
+	}
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = moveRedLeft(n)
+	}
+	n.left = rbDeleteMin(n.left)
+	return rbBalance(n)
+}
+
+// moveRedLeft makes the left child of n or one of its children red, assuming
+// n is red and both its children are black.
+func moveRedLeft[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.right.left) {
+		n.right = rotateRightRed(n.right)
+		n = rotateLeftRed(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// moveRedRight makes the right child of n or one of its children red,
+// assuming n is red and both its children are black.
+func moveRedRight[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.left.left) {
+		n = rotateRightRed(n)
+		flipColors(n)
+	}
+	return n
+}
@@ -0,0 +1,67 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"testing"
+)
+
+// checkRedBlack returns an error if t is not a binary search tree, or breaks
+// a rule of left-leaning red-black trees: the root is black, red links lean
+// left, no node has two red links and all paths from the root to a leaf have
+// the same number of black links.
+func checkRedBlack[K, V any](t *bst[K, V]) error {
+	if err := checkBST(t); err != nil {
+		return err
+	}
+	if isRed(t.root) {
+		return fmt.Errorf("root %v is red", t.root.key)
+	}
+	// blackHeight returns the number of black links on every path from n to
+	// a leaf.
+	var blackHeight func(n *node[K, V]) (int, error)
+	blackHeight = func(n *node[K, V]) (int, error) {
+		if n == nil {
+			return 0, nil
+		}
+		if isRed(n.right) {
+			return 0, fmt.Errorf("red link from %v leans right", n.key)
+		}
+		if isRed(n) && isRed(n.left) {
+			return 0, fmt.Errorf("node %v has two red links", n.key)
+		}
+		l, err := blackHeight(n.left)
+		if err != nil {
+			return 0, err
+		}
+		r, err := blackHeight(n.right)
+		if err != nil {
+			return 0, err
+		}
+		if l != r {
+			return 0, fmt.Errorf("subtrees of node %v have %d and %d black links", n.key, l, r)
+		}
+		if !isRed(n) {
+			l++
+		}
+		return l, nil
+	}
+	_, err := blackHeight(t.root)
+	return err
+}
+
+func TestRedBlackTree_Height(t *testing.T) {
+	m := NewRedBlackTree[int, int]()
+	for i := 0; i < 1<<16; i++ {
+		m.Put(i, i)
+	}
+	// The height of a red-black tree with 2^16 nodes is at most 2*17.
+	if h := height(m.root); h > 34 {
+		t.Fatalf("height of red-black tree of %d sorted keys is %d, want at most 34", m.Len(), h)
+	}
+	for i := 0; i < 1<<16; i += 2 {
+		m.Delete(i)
+	}
+	if err := checkRedBlack(&m.bst); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,179 @@
+package goalgorithms
+
+// Monoid is a set of values with an associative operation and an identity
+// element, like integers with addition and zero, or with min and the largest
+// integer.
+type Monoid[T any] struct {
+	// Identity is the value for which Combine(Identity, v) and
+	// Combine(v, Identity) are v.
+	Identity T
+	// Combine returns the result of the operation, which must be associative,
+	// but need not be commutative.
+	Combine func(a, b T) T
+}
+
+// LazySegmentTree holds an array of values of a monoid, and answers queries
+// for the combined value of a range of elements, while single elements are
+// set and updates of type U are applied to whole ranges.
+// Every node holds the combined value of a range of the array, and the
+// children of a node split its range in two halves. An update of a range is
+// applied to the O(log(n)) nodes that cover it, and kept at them as a pending
+// update of their children, which is pushed down to the children only when a
+// later operation visits them. This is lazy propagation.
+// Get, Set, Query and Update take O(log(n)) time.
+type LazySegmentTree[T, U any] struct {
+	n      int
+	monoid Monoid[T]
+	// apply returns the value of a node after update u, from its value v and
+	// the number of elements in its range.
+	apply func(u U, v T, length int) T
+	// compose returns the update that applies w and then u.
+	compose func(u, w U) U
+	// values[k] is the value of node k, whose children are 2k and 2k+1, and
+	// lazy[k] is the update of its children pending at it if pending[k].
+	values  []T
+	lazy    []U
+	pending []bool
+}
+
+// NewLazySegmentTree returns a LazySegmentTree of the given values of monoid.
+// apply(u, v, length) must return the combined value of a range of length
+// elements after update u, given their combined value v, and compose(u, w)
+// must return the update that has the same effect as applying w and then u.
+// E.g. for range additions to an array of integers with range sums, apply
+// returns v+u*length and compose returns u+w. Takes O(n) time.
+func NewLazySegmentTree[T, U any](values []T, monoid Monoid[T], apply func(u U, v T, length int) T, compose func(u, w U) U) *LazySegmentTree[T, U] {
+	nodes := 4 * max(len(values), 1)
+	t := &LazySegmentTree[T, U]{
+		n:       len(values),
+		monoid:  monoid,
+		apply:   apply,
+		compose: compose,
+		values:  make([]T, nodes),
+		lazy:    make([]U, nodes),
+		pending: make([]bool, nodes),
+	}
+	if t.n > 0 {
+		t.build(1, 0, t.n, values)
+	}
+	return t
+}
+
+// build sets the values of node k, which covers [lo, hi), and its subtree.
+func (t *LazySegmentTree[T, U]) build(k, lo, hi int, values []T) {
+	if hi-lo == 1 {
+		t.values[k] = values[lo]
+		return
+	}
+	mid := (lo + hi) / 2
+	t.build(2*k, lo, mid, values)
+	t.build(2*k+1, mid, hi, values)
+	t.values[k] = t.monoid.Combine(t.values[2*k], t.values[2*k+1])
+}
+
+// Len returns the number of elements in the array.
+func (t *LazySegmentTree[T, U]) Len() int {
+	return t.n
+}
+
+// applyNode applies update u to node k, which covers length elements, and
+// keeps it pending for the children of the node.
+func (t *LazySegmentTree[T, U]) applyNode(k, length int, u U) {
+	t.values[k] = t.apply(u, t.values[k], length)
+	if length > 1 {
+		if t.pending[k] {
+			u = t.compose(u, t.lazy[k])
+		}
+		t.lazy[k], t.pending[k] = u, true
+	}
+}
+
+// push applies the update pending at node k, which covers [lo, hi), to its
+// children.
+func (t *LazySegmentTree[T, U]) push(k, lo, hi int) {
+	if !t.pending[k] {
+		return
+	}
+	mid := (lo + hi) / 2
+	t.applyNode(2*k, mid-lo, t.lazy[k])
+	t.applyNode(2*k+1, hi-mid, t.lazy[k])
+	var zero U
+	t.lazy[k], t.pending[k] = zero, false
+}
+
+// Get returns the element at index i. It panics if i is out of range.
+func (t *LazySegmentTree[T, U]) Get(i int) T {
+	checkIndex(i, t.n)
+	return t.Query(i, i+1)
+}
+
+// Set sets the element at index i to v. It panics if i is out of range.
+func (t *LazySegmentTree[T, U]) Set(i int, v T) {
+	checkIndex(i, t.n)
+	t.set(1, 0, t.n, i, v)
+}
+
+func (t *LazySegmentTree[T, U]) set(k, lo, hi, i int, v T) {
+	if hi-lo == 1 {
+		t.values[k] = v
+		return
+	}
+	t.push(k, lo, hi)
+	if mid := (lo + hi) / 2; i < mid {
+		t.set(2*k, lo, mid, i, v)
+	} else {
+		t.set(2*k+1, mid, hi, i, v)
+	}
+	t.values[k] = t.monoid.Combine(t.values[2*k], t.values[2*k+1])
+}
+
+// Query returns the combined value of the elements in [lo, hi), which is the
+// identity of the monoid if the range is empty. It panics if the range is not
+// within [0, Len()) or lo > hi.
+func (t *LazySegmentTree[T, U]) Query(lo, hi int) T {
+	checkRange(lo, hi, t.n)
+	if lo == hi {
+		return t.monoid.Identity
+	}
+	return t.query(1, 0, t.n, lo, hi)
+}
+
+// query returns the combined value of the elements in [lo, hi) that are in
+// the range [nodeLo, nodeHi) of node k.
+func (t *LazySegmentTree[T, U]) query(k, nodeLo, nodeHi, lo, hi int) T {
+	if hi <= nodeLo || nodeHi <= lo {
+		return t.monoid.Identity
+	}
+	if lo <= nodeLo && nodeHi <= hi {
+		return t.values[k]
+	}
+	t.push(k, nodeLo, nodeHi)
+	mid := (nodeLo + nodeHi) / 2
+	return t.monoid.Combine(t.query(2*k, nodeLo, mid, lo, hi), t.query(2*k+1, mid, nodeHi, lo, hi))
+}
+
+// Update applies update u to the elements in [lo, hi). It panics if the range
+// is not within [0, Len()) or lo > hi.
+func (t *LazySegmentTree[T, U]) Update(lo, hi int, u U) {
+	checkRange(lo, hi, t.n)
+	if lo < hi {
+		t.update(1, 0, t.n, lo, hi, u)
+	}
+}
+
+// update applies update u to the elements in [lo, hi) that are in the range
+// [nodeLo, nodeHi) of node k.
+func (t *LazySegmentTree[T, U]) update(k, nodeLo, nodeHi, lo, hi int, u U) {
+	if hi <= nodeLo || nodeHi <= lo {
+		return
+	}
+	if lo <= nodeLo && nodeHi <= hi {
+		t.applyNode(k, nodeHi-nodeLo, u)
+		return
+	}
+	t.push(k, nodeLo, nodeHi)
+	mid := (nodeLo + nodeHi) / 2
+	t.update(2*k, nodeLo, mid, lo, hi, u)
+	t.update(2*k+1, mid, nodeHi, lo, hi, u)
+	t.values[k] = t.monoid.Combine(t.values[2*k], t.values[2*k+1])
+}
@@ -0,0 +1,170 @@
+package goalgorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// sumMonoid and minMonoid are monoids of integers.
+var (
+	sumMonoid = Monoid[int]{Identity: 0, Combine: func(a, b int) int { return a + b }}
+	minMonoid = Monoid[int]{Identity: math.MaxInt, Combine: func(a, b int) int { return min(a, b) }}
+)
+
+// affine is the update that changes every element x to a*x + b.
+type affine struct{ a, b int }
+
+func TestLazySegmentTree(t *testing.T) {
+	add := func(u, v, length int) int { return v + u*length }
+	sum := NewLazySegmentTree([]int{5, 3, 8, 1, 4}, sumMonoid, add, func(u, w int) int { return u + w })
+	if got := sum.Len(); got != 5 {
+		t.Errorf("Len() = %d, want 5", got)
+	}
+	if got := sum.Query(1, 4); got != 12 {
+		t.Errorf("Query(1, 4) = %d, want 12", got)
+	}
+	if got := sum.Query(2, 2); got != 0 {
+		t.Errorf("Query(2, 2) = %d, want 0", got)
+	}
+	sum.Update(0, 3, 10)
+	sum.Set(1, 0)
+	if got := sum.Query(0, 5); got != 38 {
+		t.Errorf("Query(0, 5) after updates = %d, want 38", got)
+	}
+	if got := sum.Get(2); got != 18 {
+		t.Errorf("Get(2) after updates = %d, want 18", got)
+	}
+
+	// Concatenation is not commutative, so this checks the order in which
+	// the values are combined.
+	concat := Monoid[string]{Identity: "", Combine: func(a, b string) string { return a + b }}
+	fill := func(c byte, v string, length int) string { return strings.Repeat(string(c), length) }
+	s := NewLazySegmentTree(strings.Split("abcdefg", ""), concat, fill, func(u, w byte) byte { return u })
+	s.Update(1, 4, 'x')
+	s.Update(3, 6, 'y')
+	if got := s.Query(0, 7); got != "axxyyyg" {
+		t.Errorf("Query(0, 7) = %q, want \"axxyyyg\"", got)
+	}
+	if got := s.Query(2, 5); got != "xyy" {
+		t.Errorf("Query(2, 5) = %q, want \"xyy\"", got)
+	}
+
+	empty := NewLazySegmentTree(nil, minMonoid, add, func(u, w int) int { return u + w })
+	if got := empty.Query(0, 0); got != math.MaxInt {
+		t.Errorf("Query(0, 0) of empty tree = %d, want MaxInt", got)
+	}
+}
+
+func TestLazySegmentTree_IndexOutOfRange(t *testing.T) {
+	tests := []struct {
+		n, i int
+	}{
+		{3, -4},
+		{3, -1},
+		{3, 3},
+		{3, 5},
+		{0, 0},
+	}
+	add := func(u, v, length int) int { return v + u*length }
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("n=%d/i=%d", tt.n, tt.i), func(t *testing.T) {
+			st := NewLazySegmentTree(make([]int, tt.n), sumMonoid, add, func(u, w int) int { return u + w })
+			want := fmt.Sprintf("[%d]", tt.i)
+			checkPanics(t, fmt.Sprintf("Set(%d, 1)", tt.i), want, func() { st.Set(tt.i, 1) })
+			checkPanics(t, fmt.Sprintf("Get(%d)", tt.i), want, func() { st.Get(tt.i) })
+			if got := st.Query(0, tt.n); got != 0 {
+				t.Errorf("Query(0, %d) = %d after Set out of range, want 0", tt.n, got)
+			}
+		})
+	}
+	st := NewLazySegmentTree([]int{1, 2, 3}, sumMonoid, add, func(u, w int) int { return u + w })
+	for _, r := range badRanges {
+		want := fmt.Sprintf("[%d:%d]", r.lo, r.hi)
+		checkPanics(t, fmt.Sprintf("Query(%d, %d)", r.lo, r.hi), want, func() { st.Query(r.lo, r.hi) })
+		checkPanics(t, fmt.Sprintf("Update(%d, %d, 1)", r.lo, r.hi), want, func() { st.Update(r.lo, r.hi, 1) })
+	}
+	if got := st.Query(0, 3); got != 6 {
+		t.Errorf("Query(0, 3) = %d after Update out of range, want 6", got)
+	}
+}
+
+// checkLazySegmentTree applies random updates from randomUpdate to trees of
+// several sizes, and compares their answers with values computed by naive
+// loops, which apply updates to the elements one by one with applyOne.
+func checkLazySegmentTree[U any](t *testing.T, monoid Monoid[int], apply func(u U, v, length int) int, compose func(u, w U) U, randomUpdate func(rnd *rand.Rand) U, applyOne func(u U, x int) int) {
+	t.Helper()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 7, 16, 100} {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = rnd.Intn(100)
+		}
+		st := NewLazySegmentTree(values, monoid, apply, compose)
+		values = append([]int(nil), values...)
+		for op := 0; op < 2000; op++ {
+			lo := rnd.Intn(n + 1)
+			hi := lo + rnd.Intn(n+1-lo)
+			switch rnd.Intn(3) {
+			case 0:
+				u := randomUpdate(rnd)
+				st.Update(lo, hi, u)
+				for i := lo; i < hi; i++ {
+					values[i] = applyOne(u, values[i])
+				}
+			case 1:
+				i, v := rnd.Intn(n), rnd.Intn(100)
+				st.Set(i, v)
+				values[i] = v
+			default:
+				want := monoid.Identity
+				for _, v := range values[lo:hi] {
+					want = monoid.Combine(want, v)
+				}
+				if got := st.Query(lo, hi); got != want {
+					t.Fatalf("n=%d: Query(%d, %d) = %d, want %d", n, lo, hi, got, want)
+				}
+			}
+		}
+		for i, want := range values {
+			if got := st.Get(i); got != want {
+				t.Fatalf("n=%d: Get(%d) = %d, want %d", n, i, got, want)
+			}
+		}
+	}
+}
+
+func TestLazySegmentTree_Random(t *testing.T) {
+	t.Run("SumWithAdd", func(t *testing.T) {
+		checkLazySegmentTree(t, sumMonoid,
+			func(u, v, length int) int { return v + u*length },
+			func(u, w int) int { return u + w },
+			func(rnd *rand.Rand) int { return rnd.Intn(21) - 10 },
+			func(u, x int) int { return x + u })
+	})
+	t.Run("MinWithAssign", func(t *testing.T) {
+		checkLazySegmentTree(t, minMonoid,
+			func(u, v, length int) int { return u },
+			func(u, w int) int { return u },
+			func(rnd *rand.Rand) int { return rnd.Intn(100) },
+			func(u, x int) int { return u })
+	})
+	t.Run("MinWithAdd", func(t *testing.T) {
+		checkLazySegmentTree(t, minMonoid,
+			func(u, v, length int) int { return v + u },
+			func(u, w int) int { return u + w },
+			func(rnd *rand.Rand) int { return rnd.Intn(21) - 10 },
+			func(u, x int) int { return x + u })
+	})
+	// Affine updates do not commute, so this checks the order in which they
+	// are composed.
+	t.Run("SumWithAffine", func(t *testing.T) {
+		checkLazySegmentTree(t, sumMonoid,
+			func(u affine, v, length int) int { return u.a*v + u.b*length },
+			func(u, w affine) affine { return affine{u.a * w.a, u.a*w.b + u.b} },
+			func(rnd *rand.Rand) affine { return affine{rnd.Intn(3) - 1, rnd.Intn(11) - 5} },
+			func(u affine, x int) int { return u.a*x + u.b })
+	})
+}
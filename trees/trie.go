@@ -0,0 +1,150 @@
+package goalgorithms
+
+import (
+	"bytes"
+	"iter"
+	"slices"
+)
+
+// Trie is a PrefixMap implemented as a trie, a tree with a node for every
+// prefix of the keys and an edge for every byte. The path from the root to a
+// node spells its prefix, so all operations take O(m) time, where m is the
+// length of the key, no matter how many keys there are.
+type Trie[V any] struct {
+	root trieNode[V]
+	size int
+}
+
+// trieNode is a node of a Trie. labels holds the bytes of the edges to the
+// children, in ascending order.
+type trieNode[V any] struct {
+	labels   []byte
+	children []*trieNode[V]
+	value    V
+	hasValue bool
+}
+
+// NewTrie returns an empty Trie.
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+// child returns the child of n on the edge with byte c, or nil.
+func (n *trieNode[V]) child(c byte) *trieNode[V] {
+	if i := bytes.IndexByte(n.labels, c); i >= 0 {
+		return n.children[i]
+	}
+	return nil
+}
+
+// Len returns the number of keys in the map.
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// find returns the node of key, or nil if there is none.
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	n := &t.root
+	for i := 0; i < len(key) && n != nil; i++ {
+		n = n.child(key[i])
+	}
+	return n
+}
+
+// Insert sets the value of key, adding key if it is not in the map.
+func (t *Trie[V]) Insert(key string, value V) {
+	n := &t.root
+	for i := 0; i < len(key); i++ {
+		next := n.child(key[i])
+		if next == nil {
+			next = &trieNode[V]{}
+			j, _ := slices.BinarySearch(n.labels, key[i])
+			n.labels = slices.Insert(n.labels, j, key[i])
+			n.children = slices.Insert(n.children, j, next)
+		}
+		n = next
+	}
+	if !n.hasValue {
+		t.size++
+	}
+	n.value, n.hasValue = value, true
+}
+
+// Get returns the value of key, and whether key is in the map.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	if n := t.find(key); n != nil && n.hasValue {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes key from the map, and reports whether it was in the map.
+// Nodes that no longer lead to any key are removed.
+func (t *Trie[V]) Delete(key string) bool {
+	// path holds the nodes from the root to the node of key.
+	path := []*trieNode[V]{&t.root}
+	for i := 0; i < len(key); i++ {
+		n := path[i].child(key[i])
+		if n == nil {
+			return false
+		}
+		path = append(path, n)
+	}
+	n := path[len(key)]
+	if !n.hasValue {
+		return false
+	}
+	var zero V
+	n.value, n.hasValue = zero, false
+	t.size--
+	for i := len(key); i > 0 && !path[i].hasValue && len(path[i].children) == 0; i-- {
+		parent := path[i-1]
+		j := bytes.IndexByte(parent.labels, key[i-1])
+		parent.labels = slices.Delete(parent.labels, j, j+1)
+		parent.children = slices.Delete(parent.children, j, j+1)
+	}
+	return true
+}
+
+// PrefixIterate returns an iterator over the keys that start with prefix and
+// their values, in ascending order of the keys.
+func (t *Trie[V]) PrefixIterate(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		if n := t.find(prefix); n != nil {
+			n.walk([]byte(prefix), yield)
+		}
+	}
+}
+
+// walk calls yield for the keys in the subtree of n, whose prefix is key, in
+// order. It returns false if yield did.
+func (n *trieNode[V]) walk(key []byte, yield func(string, V) bool) bool {
+	if n.hasValue && !yield(string(key), n.value) {
+		return false
+	}
+	for i, child := range n.children {
+		if !child.walk(append(key, n.labels[i]), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// LongestPrefixOf returns the longest key that is a prefix of s, and its
+// value, or false if there is none.
+func (t *Trie[V]) LongestPrefixOf(s string) (string, V, bool) {
+	var value V
+	length, found := 0, false
+	n := &t.root
+	for i := 0; n != nil; i++ {
+		if n.hasValue {
+			value, length, found = n.value, i, true
+		}
+		if i == len(s) {
+			break
+		}
+		n = n.child(s[i])
+	}
+	return s[:length], value, found
+}
@@ -0,0 +1,224 @@
+package goalgorithms
+
+import "iter"
+
+// TernarySearchTree is a PrefixMap implemented as a ternary search tree, as
+// described by Bentley and Sedgewick. Every node holds a byte and has three
+// children: the keys that continue with the byte are under the middle child,
+// and the keys with a smaller or greater byte at the same position are under
+// the left and right children, which form a binary search tree. It uses much
+// less memory than a Trie, as nodes have no arrays of children, and takes
+// O(m + log(n)) time for all operations on balanced trees, where m is the
+// length of the key. Inserting keys in random order keeps the trees balanced.
+type TernarySearchTree[V any] struct {
+	root *tstNode[V]
+	// The empty key has no node, so its value is stored separately.
+	empty    V
+	hasEmpty bool
+	size     int
+}
+
+// tstNode is a node of a TernarySearchTree. It holds the value of the key
+// that ends with its byte, on the path that leads to it through middle
+// children.
+type tstNode[V any] struct {
+	c                byte
+	left, mid, right *tstNode[V]
+	value            V
+	hasValue         bool
+}
+
+// NewTernarySearchTree returns an empty TernarySearchTree.
+func NewTernarySearchTree[V any]() *TernarySearchTree[V] {
+	return &TernarySearchTree[V]{}
+}
+
+// Len returns the number of keys in the map.
+func (t *TernarySearchTree[V]) Len() int {
+	return t.size
+}
+
+// find returns the node of the last byte of key, which must not be empty, or
+// nil if there is none.
+func (t *TernarySearchTree[V]) find(key string) *tstNode[V] {
+	n := t.root
+	for i := 0; n != nil; {
+		switch {
+		case key[i] < n.c:
+			n = n.left
+		case key[i] > n.c:
+			n = n.right
+		case i == len(key)-1:
+			return n
+		default:
+			n = n.mid
+			i++
+		}
+	}
+	return nil
+}
+
+// Insert sets the value of key, adding key if it is not in the map.
+func (t *TernarySearchTree[V]) Insert(key string, value V) {
+	if key == "" {
+		if !t.hasEmpty {
+			t.size++
+		}
+		t.empty, t.hasEmpty = value, true
+		return
+	}
+	link := &t.root
+	for i := 0; ; {
+		n := *link
+		if n == nil {
+			n = &tstNode[V]{c: key[i]}
+			*link = n
+		}
+		switch {
+		case key[i] < n.c:
+			link = &n.left
+		case key[i] > n.c:
+			link = &n.right
+		case i == len(key)-1:
+			if !n.hasValue {
+				t.size++
+			}
+			n.value, n.hasValue = value, true
+			return
+		default:
+			link = &n.mid
+			i++
+		}
+	}
+}
+
+// Get returns the value of key, and whether key is in the map.
+func (t *TernarySearchTree[V]) Get(key string) (V, bool) {
+	if key == "" {
+		return t.empty, t.hasEmpty
+	}
+	if n := t.find(key); n != nil && n.hasValue {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes key from the map, and reports whether it was in the map.
+// Nodes that no longer lead to any key are removed.
+func (t *TernarySearchTree[V]) Delete(key string) bool {
+	if key == "" {
+		deleted := t.hasEmpty
+		if deleted {
+			var zero V
+			t.empty, t.hasEmpty = zero, false
+			t.size--
+		}
+		return deleted
+	}
+	deleted := false
+	t.root = t.delete(t.root, key, 0, &deleted)
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+// delete removes key from the subtree of n, in which key[i] is the byte to
+// look for, and returns the new root of the subtree.
+func (t *TernarySearchTree[V]) delete(n *tstNode[V], key string, i int, deleted *bool) *tstNode[V] {
+	if n == nil {
+		return nil
+	}
+	switch {
+	case key[i] < n.c:
+		n.left = t.delete(n.left, key, i, deleted)
+	case key[i] > n.c:
+		n.right = t.delete(n.right, key, i, deleted)
+	case i == len(key)-1:
+		if n.hasValue {
+			var zero V
+			n.value, n.hasValue = zero, false
+			*deleted = true
+		}
+	default:
+		n.mid = t.delete(n.mid, key, i+1, deleted)
+	}
+	if n.hasValue || n.mid != nil {
+		return n
+	}
+	// The node leads to no key, so remove it from the binary search tree of
+	// its byte position, like a node of an unbalanced binary search tree.
+	if n.left == nil {
+		return n.right
+	}
+	if n.right == nil {
+		return n.left
+	}
+	last := n.left
+	for last.right != nil {
+		last = last.right
+	}
+	last.right = n.right
+	return n.left
+}
+
+// PrefixIterate returns an iterator over the keys that start with prefix and
+// their values, in ascending order of the keys.
+func (t *TernarySearchTree[V]) PrefixIterate(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		if prefix == "" {
+			if t.hasEmpty && !yield("", t.empty) {
+				return
+			}
+			t.walk(t.root, nil, yield)
+			return
+		}
+		n := t.find(prefix)
+		if n == nil {
+			return
+		}
+		if n.hasValue && !yield(prefix, n.value) {
+			return
+		}
+		t.walk(n.mid, []byte(prefix), yield)
+	}
+}
+
+// walk calls yield for the keys in the subtree of n, whose prefix is key, in
+// order. It returns false if yield did.
+func (t *TernarySearchTree[V]) walk(n *tstNode[V], key []byte, yield func(string, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if !t.walk(n.left, key, yield) {
+		return false
+	}
+	key = append(key, n.c)
+	if n.hasValue && !yield(string(key), n.value) {
+		return false
+	}
+	return t.walk(n.mid, key, yield) && t.walk(n.right, key[:len(key)-1], yield)
+}
+
+// LongestPrefixOf returns the longest key that is a prefix of s, and its
+// value, or false if there is none.
+func (t *TernarySearchTree[V]) LongestPrefixOf(s string) (string, V, bool) {
+	value, length, found := t.empty, 0, t.hasEmpty
+	n := t.root
+	for i := 0; n != nil && i < len(s); {
+		switch {
+		case s[i] < n.c:
+			n = n.left
+		case s[i] > n.c:
+			n = n.right
+		default:
+			i++
+			if n.hasValue {
+				value, length, found = n.value, i, true
+			}
+			n = n.mid
+		}
+	}
+	return s[:length], value, found
+}